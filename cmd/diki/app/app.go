@@ -5,24 +5,38 @@
 package app
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/rest"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/diki/cmd/internal/slogr"
+	"github.com/gardener/diki/pkg/baseline"
 	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/config/schema"
+	"github.com/gardener/diki/pkg/config/validation"
+	"github.com/gardener/diki/pkg/kubernetes/snapshot"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/report"
@@ -30,6 +44,54 @@ import (
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
+const (
+	// ExitCodeError is the exit code used when diki fails to execute.
+	ExitCodeError = 1
+	// ExitCodeFindings is the exit code used when diki run reports
+	// checks at or above the thresholds set with --fail-on and --fail-on-severity
+	// or when diki report diff with --exit-code finds new Failed or Errored checks.
+	ExitCodeFindings = 2
+)
+
+var (
+	// ErrFindings is returned when diki run reports checks
+	// at or above the thresholds set with --fail-on and --fail-on-severity.
+	ErrFindings = errors.New("found checks at or above the fail threshold")
+	// ErrRegressions is returned when diki report diff with --exit-code
+	// finds checks with status Failed or Errored that are not present in the old report.
+	ErrRegressions = errors.New("found new Failed or Errored checks")
+)
+
+// ExitCode returns the exit code that corresponds to an error returned by the diki command.
+// Joined errors only result in [ExitCodeFindings] if all of them wrap [ErrFindings] or [ErrRegressions].
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case isFindingsError(err):
+		return ExitCodeFindings
+	default:
+		return ExitCodeError
+	}
+}
+
+// isFindingsError returns true if err is or only wraps [ErrFindings] and [ErrRegressions].
+func isFindingsError(err error) bool {
+	if err == ErrFindings || err == ErrRegressions {
+		return true
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		return isFindingsError(wrapped.Unwrap())
+	case interface{ Unwrap() []error }:
+		errs := wrapped.Unwrap()
+		return len(errs) > 0 && !slices.ContainsFunc(errs, func(e error) bool { return !isFindingsError(e) })
+	default:
+		return false
+	}
+}
+
 // NewDikiCommand creates a new command that is used to start Diki.
 func NewDikiCommand(providerOptions map[string]provider.ProviderOption) *cobra.Command {
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
@@ -80,6 +142,8 @@ e.g. to check compliance of your hyperscaler accounts.`,
 		Short: "Run some rulesets and rules.",
 		Long:  "Run allows running rulesets and rules for the given provider(s).",
 		RunE: func(c *cobra.Command, _ []string) error {
+			// flags are already parsed, errors from here on should not print the usage
+			c.SilenceUsage = true
 			return runCmd(c.Context(), providerCreateFuncs, opts, logger)
 		},
 	}
@@ -87,6 +151,34 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	addRunFlags(runCmd, &opts)
 	rootCmd.AddCommand(runCmd)
 
+	var snapshotOpts snapshotOptions
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Write a snapshot of the objects of a cluster.",
+		Long:  "Snapshot writes the objects of a kubernetes cluster that are read by the rules of the snapshot provider, i.e. pods, namespaces, roles, network policies, storage classes, services, nodes and the workloads owning pods, to a gzip compressed tarball. Rulesets can be run against the snapshot with the snapshot provider without access to the cluster.",
+		RunE: func(c *cobra.Command, _ []string) error {
+			c.SilenceUsage = true
+			return snapshotCmd(c.Context(), snapshotOpts, logger)
+		},
+	}
+
+	addSnapshotFlags(snapshotCmd, &snapshotOpts)
+	rootCmd.AddCommand(snapshotCmd)
+
+	var validateOpts validateOptions
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate a configuration file.",
+		Long:  "Validate checks a configuration file for unknown providers, rulesets, versions and rules, duplicate entries and invalid arguments without connecting to any cluster.",
+		RunE: func(c *cobra.Command, _ []string) error {
+			c.SilenceUsage = true
+			return validateCmd(validateOpts, providerOptions)
+		},
+	}
+
+	addValidateFlags(validateCmd, &validateOpts)
+	rootCmd.AddCommand(validateCmd)
+
 	var reportOpts reportOptions
 	reportCmd := &cobra.Command{
 		Use:   "report",
@@ -118,7 +210,8 @@ e.g. to check compliance of your hyperscaler accounts.`,
 		Use:   "diff",
 		Short: "Report diff creates difference between two reports.",
 		Long:  "Report diff creates difference between two reports.",
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(c *cobra.Command, _ []string) error {
+			c.SilenceUsage = true
 			return diffCmd(reportOpts, diffOpts)
 		},
 	}
@@ -126,11 +219,51 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	addReportDiffFlags(diffCmd, &diffOpts)
 	reportCmd.AddCommand(diffCmd)
 
+	var assembleOpts assembleOptions
+	assembleCmd := &cobra.Command{
+		Use:   "assemble",
+		Short: "Report assemble creates a report from stream output files.",
+		Long:  "Report assemble creates a report from one or more JSON Lines files written by 'diki run --stream-output'.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return assembleCmd(args, reportOpts, assembleOpts)
+		},
+	}
+
+	addReportAssembleFlags(assembleCmd, &assembleOpts)
+	reportCmd.AddCommand(assembleCmd)
+
+	var trendOpts trendOptions
+	trendCmd := &cobra.Command{
+		Use:   "trend",
+		Short: "Report trend shows the change of results over multiple reports.",
+		Long:  "Report trend orders the reports of a cluster by their time and shows the number of checks per status of each ruleset and rule over time, as well as when each finding was first seen and resolved.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return trendCmd(args, reportOpts, trendOpts, logger)
+		},
+	}
+
+	addReportTrendFlags(trendCmd, &trendOpts)
+	reportCmd.AddCommand(trendCmd)
+
+	var verifyOpts verifyOptions
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Report verify checks the signature of a report.",
+		Long:  "Report verify checks the detached signature written by 'diki run --sign-key' against the canonical json of a report and prints the identity of the signer.",
+		RunE: func(c *cobra.Command, args []string) error {
+			c.SilenceUsage = true
+			return verifyCmd(args, verifyOpts)
+		},
+	}
+
+	addReportVerifyFlags(verifyCmd, &verifyOpts)
+	reportCmd.AddCommand(verifyCmd)
+
 	var generateDiffOpts generateDiffOptions
 	generateDiffCmd := &cobra.Command{
 		Use:   "diff",
-		Short: "Generate diff combines difference reports into an html report.",
-		Long:  "Generate diff combines difference reports into an html report.",
+		Short: "Generate diff combines difference reports into an html or markdown report.",
+		Long:  "Generate diff combines difference reports into an html or markdown report.",
 		RunE: func(_ *cobra.Command, args []string) error {
 			return generateDiffCmd(args, generateDiffOpts, reportOpts, logger)
 		},
@@ -161,6 +294,45 @@ e.g. to check compliance of your hyperscaler accounts.`,
 
 	showCmd.AddCommand(showProviderCmd)
 
+	var showSchemaOpts showSchemaOptions
+	showSchemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Show the JSON Schema of the diki configuration file.",
+		Long:  "Show the JSON Schema of the diki configuration file, including the arguments of providers, rulesets and rules.",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return showSchemaCmd(showSchemaOpts, providerOptions)
+		},
+	}
+
+	addShowSchemaFlags(showSchemaCmd, &showSchemaOpts)
+	showCmd.AddCommand(showSchemaCmd)
+
+	var showRulesetOpts showRulesetOptions
+	showRulesetCmd := &cobra.Command{
+		Use:   "ruleset <provider> <ruleset-id> <ruleset-version>",
+		Short: "Show the rules of a ruleset version.",
+		Long:  "Show the rules of a ruleset version with their names, severities, whether they are implemented and whether they accept arguments.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return showRulesetCmd(args, showRulesetOpts, providerOptions)
+		},
+	}
+
+	addShowRulesetFlags(showRulesetCmd, &showRulesetOpts)
+	showCmd.AddCommand(showRulesetCmd)
+
+	var showRuleOpts showRulesetOptions
+	showRuleCmd := &cobra.Command{
+		Use:   "rule <provider> <ruleset-id> <ruleset-version> <rule-id>",
+		Short: "Show detailed information for a rule.",
+		Long:  "Show detailed information for a rule, including the justification of rules that are not implemented and the JSON Schema of the rule arguments.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return showRuleCmd(args, showRuleOpts, providerOptions)
+		},
+	}
+
+	addShowRulesetFlags(showRuleCmd, &showRuleOpts)
+	showCmd.AddCommand(showRuleCmd)
+
 	return rootCmd
 }
 
@@ -175,23 +347,97 @@ func addRunFlags(cmd *cobra.Command, opts *runOptions) {
 	cmd.PersistentFlags().StringVar(&opts.provider, "provider", "", "The provider that should be used to run checks.")
 	cmd.PersistentFlags().StringVar(&opts.rulesetID, "ruleset-id", "", "The id of the ruleset that should be run. If provided --ruleset-version should also be set. If both flags are empty all rulesets for the provider will be run.")
 	cmd.PersistentFlags().StringVar(&opts.rulesetVersion, "ruleset-version", "", "The version of the ruleset that should be run. If provided --ruleset-id should also be set. If both flags are empty all rulesets for the provider will be run.")
-	cmd.PersistentFlags().StringVar(&opts.ruleID, "rule-id", "", "If set only the rule with the provided id will be run.")
+	cmd.PersistentFlags().StringVar(&opts.ruleID, "rule-id", "", "If set only the rule with the provided id will be run. If provided --ruleset-id and --ruleset-version should also be set.")
+	cmd.PersistentFlags().StringSliceVar(&opts.rules, "rules", nil, "If set only rules with matching ids will be run. The values can be rule ids or glob patterns, e.g. '242400,2424*'.")
+	cmd.PersistentFlags().StringSliceVar(&opts.excludeRules, "exclude-rules", nil, "If set rules with matching ids will not be run. The values can be rule ids or glob patterns, e.g. '242400,2424*'.")
+	cmd.PersistentFlags().StringVar(&opts.minSeverity, "min-severity", "", "If set only rules with the given severity or a higher one will be run. Severity can be one of 'Low', 'Medium' or 'High'.")
+	cmd.PersistentFlags().StringVar(&opts.streamOutputPath, "stream-output", "", "If set diki appends each rule result as a JSON Lines record to the given file path as soon as the rule run finishes. A report can be assembled from the file with 'diki report assemble'.")
+	cmd.PersistentFlags().StringVar(&opts.resumePath, "resume", "", "If set diki does not run rules whose results for the same provider, ruleset and version are already present in the given file written by --stream-output. Rules that timed out or have errored checks are run again.")
+	cmd.PersistentFlags().StringVar(&opts.signKeyPath, "sign-key", "", "If set diki writes a detached signature of the json report to the output path with a '.sig' suffix. The file must contain a PEM encoded ed25519, ecdsa or rsa private key, optionally followed by the x509 certificate chain of the key.")
+	cmd.PersistentFlags().StringVar(&opts.baselinePath, "baseline", "", "If set diki accepts the findings listed in the given baseline file. Overrides the baseline set in the configuration file.")
+	cmd.PersistentFlags().StringVar(&opts.failOn, "fail-on", "", "If set diki exits with code 2 when a check has the given status or a higher one. Ordered from lowest to highest priority, Status can be one of 'Passed', 'Skipped', 'Accepted', 'Warning', 'Failed', 'Errored' or 'Not Implemented'. Checks of rules that are not implemented are only considered when Status is 'Not Implemented'.")
+	cmd.PersistentFlags().StringVar(&opts.failOnSeverity, "fail-on-severity", "", "If set only checks of rules with the given severity or a higher one are considered by --fail-on, which defaults to 'Failed'. Severity can be one of 'Low', 'Medium' or 'High'.")
+}
+
+func addSnapshotFlags(cmd *cobra.Command, opts *snapshotOptions) {
+	cmd.PersistentFlags().StringVar(&opts.kubeconfigPath, "kubeconfig", "", "Path to the kubeconfig of the cluster. If not set the in-cluster configuration is used.")
+	cmd.PersistentFlags().StringVar(&opts.outputPath, "output", "", "Path of the snapshot file that is written.")
+}
+
+func addValidateFlags(cmd *cobra.Command, opts *validateOptions) {
+	cmd.PersistentFlags().StringVar(&opts.configFile, "config", "", "Configuration file for diki that should be validated.")
 }
 
 func addReportGenerateFlags(cmd *cobra.Command, opts *generateOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.distinctBy), "distinct-by", "If set generates a merged report. The keys are the IDs for the providers which the merged report will include and the values are distinct metadata attributes to be used as IDs for the different reports.")
-	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html' or 'json'.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html', 'markdown', 'json', 'sarif', 'junit', 'csv' or 'xlsx'. The 'sarif' and 'junit' formats do not support merged reports.")
+	cmd.PersistentFlags().IntVar(&opts.maxTargets, "max-targets", 0, "If set to a positive number limits the number of targets listed per check. Only applies to the 'markdown' format.")
 	cmd.PersistentFlags().StringVar(&opts.minStatus, "min-status", "Passed", "If set specifies the minimal status that will be included in the generated report. Ordered from lowest to highest priority, Status can be one of 'Passed', 'Skipped', 'Accepted', 'Warning', 'Failed', 'Errored' or 'NotImplemented'")
+	cmd.PersistentFlags().StringVar(&opts.trustPath, "trust", "", "If set the signature of the report is verified with the PEM encoded public keys and certificates of the given file and the result is shown in the footer of the report. Only applies to the 'html' format.")
+	cmd.PersistentFlags().StringVar(&opts.signaturePath, "signature", "", "The signature file of the report. Defaults to the report path with a '.sig' suffix.")
 }
 
 func addReportDiffFlags(cmd *cobra.Command, opts *diffOptions) {
 	cmd.PersistentFlags().StringVar(&opts.oldReport, "old", "", "Old report path.")
 	cmd.PersistentFlags().StringVar(&opts.newReport, "new", "", "New report path.")
 	cmd.PersistentFlags().StringVar(&opts.title, "title", "", "The title of a difference report.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "json", "Format of the difference. Format can be one of 'json', 'text' for a unified text diff or 'summary' for a json summary with the number of added and removed checks per status.")
+	cmd.PersistentFlags().BoolVar(&opts.exitCode, "exit-code", false, "If set the command exits with code 2 when checks with status Failed or Errored were added.")
+}
+
+func addReportAssembleFlags(cmd *cobra.Command, opts *assembleOptions) {
+	cmd.PersistentFlags().StringVar(&opts.configFile, "config", "", "If set the metadata and output minStatus of the given diki configuration file are applied to the assembled report.")
+}
+
+func addReportTrendFlags(cmd *cobra.Command, opts *trendOptions) {
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html' or 'json'.")
+	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.identityAttributes), "identity-attributes", "The keys are the IDs of providers and the values are metadata attributes that identify the cluster. If set reports whose providers have different values of these attributes are rejected.")
+}
+
+func addReportVerifyFlags(cmd *cobra.Command, opts *verifyOptions) {
+	cmd.PersistentFlags().StringVar(&opts.trustPath, "trust", "", "File with the PEM encoded public keys and certificates that are trusted to sign reports.")
+	cmd.PersistentFlags().StringVar(&opts.signaturePath, "signature", "", "The signature file of the report. Defaults to the report path with a '.sig' suffix.")
 }
 
 func addReportGenerateDiffFlags(cmd *cobra.Command, opts *generateDiffOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.identityAttributes), "identity-attributes", "The keys are the IDs of the providers that will be present in the generated difference report and the values are metadata attributes to be used as identifiers.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html' or 'markdown'.")
+}
+
+func addShowSchemaFlags(cmd *cobra.Command, opts *showSchemaOptions) {
+	cmd.PersistentFlags().StringVar(&opts.provider, "provider", "", "If set the schema only describes the given provider.")
+	cmd.PersistentFlags().StringVar(&opts.rulesetID, "ruleset", "", "If set the schema only describes the given ruleset. If provided --provider should also be set.")
+	cmd.PersistentFlags().StringVar(&opts.rulesetVersion, "version", "", "If set the schema only describes the given ruleset version. If provided --ruleset should also be set.")
+}
+
+func addShowRulesetFlags(cmd *cobra.Command, opts *showRulesetOptions) {
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "json", "Format of the output. Format can be one of 'json' or 'table'.")
+}
+
+func validateCmd(opts validateOptions, providerOptions map[string]provider.ProviderOption) error {
+	if len(opts.configFile) == 0 {
+		return errors.New("--config should be set")
+	}
+
+	fileData, err := os.ReadFile(filepath.Clean(opts.configFile))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", opts.configFile, err)
+	}
+
+	errs := validation.ValidateConfig(fileData, providerOptions)
+	if len(errs) == 0 {
+		fmt.Printf("%s is valid\n", opts.configFile)
+		return nil
+	}
+
+	for _, err := range errs {
+		if len(err.Path) == 0 {
+			fmt.Printf("%s:%d: %s\n", opts.configFile, err.Line, err.Message)
+			continue
+		}
+		fmt.Printf("%s:%d: %s: %s\n", opts.configFile, err.Line, err.Path, err.Message)
+	}
+	return fmt.Errorf("configuration file %s is invalid: found %d problem(s)", opts.configFile, len(errs))
 }
 
 func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFunc) error {
@@ -202,7 +448,7 @@ func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFu
 	if len(args) == 0 {
 		var providersMetadata []metadata.Provider
 
-		for providerID := range metadataFuncs {
+		for _, providerID := range slices.Sorted(maps.Keys(metadataFuncs)) {
 			providersMetadata = append(providersMetadata, metadata.Provider{ID: providerID, Name: metadataFuncs[providerID]().Name})
 		}
 
@@ -227,6 +473,168 @@ func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFu
 	return nil
 }
 
+func showSchemaCmd(opts showSchemaOptions, providerOptions map[string]provider.ProviderOption) error {
+	configSchema, err := schema.ForConfig(providerOptions, schema.Options{
+		ProviderID:     opts.provider,
+		RulesetID:      opts.rulesetID,
+		RulesetVersion: opts.rulesetVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	bytes, err := json.MarshalIndent(configSchema, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+	return nil
+}
+
+func showRulesetCmd(args []string, opts showRulesetOptions, providerOptions map[string]provider.ProviderOption) error {
+	if len(args) != 3 {
+		return errors.New("command 'show ruleset' requires a provider, ruleset id and ruleset version")
+	}
+
+	rulesetMetadata, err := rulesetMetadata(providerOptions, args[0], args[1], args[2])
+	if err != nil {
+		return err
+	}
+
+	switch opts.format {
+	case "json":
+		return printJSON(rulesetMetadata)
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSEVERITY\tSTATUS\tCONFIGURABLE\tNAME")
+		for _, r := range rulesetMetadata.Rules {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n", r.ID, r.Severity, ruleStatus(r), r.Configurable, r.Name)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown format: %s", opts.format)
+	}
+}
+
+func showRuleCmd(args []string, opts showRulesetOptions, providerOptions map[string]provider.ProviderOption) error {
+	if len(args) != 4 {
+		return errors.New("command 'show rule' requires a provider, ruleset id, ruleset version and rule id")
+	}
+
+	rulesetMetadata, err := rulesetMetadata(providerOptions, args[0], args[1], args[2])
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(rulesetMetadata.Rules, func(r metadata.Rule) bool { return r.ID == args[3] })
+	if idx < 0 {
+		return fmt.Errorf("rule %s does not exist in ruleset %s version %s", args[3], args[1], args[2])
+	}
+	ruleMetadata := rulesetMetadata.Rules[idx]
+
+	switch opts.format {
+	case "json":
+		return printJSON(ruleMetadata)
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "ID:\t%s\n", ruleMetadata.ID)
+		fmt.Fprintf(w, "Name:\t%s\n", ruleMetadata.Name)
+		fmt.Fprintf(w, "Severity:\t%s\n", ruleMetadata.Severity)
+		fmt.Fprintf(w, "Status:\t%s\n", ruleStatus(ruleMetadata))
+		if len(ruleMetadata.Justification) > 0 {
+			fmt.Fprintf(w, "Justification:\t%s\n", ruleMetadata.Justification)
+		}
+		for _, key := range slices.Sorted(maps.Keys(ruleMetadata.Labels)) {
+			fmt.Fprintf(w, "Label:\t%s=%s\n", key, ruleMetadata.Labels[key])
+		}
+		fmt.Fprintf(w, "Configurable:\t%t\n", ruleMetadata.Configurable)
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if ruleMetadata.Configurable {
+			var argsSchema bytes.Buffer
+			if err := json.Indent(&argsSchema, ruleMetadata.ArgsSchema, "", "  "); err != nil {
+				return err
+			}
+			fmt.Printf("Arguments schema:\n%s\n", argsSchema.String())
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.format)
+	}
+}
+
+// rulesetMetadata returns the rules of a ruleset version without connecting to any cluster.
+func rulesetMetadata(providerOptions map[string]provider.ProviderOption, providerID, rulesetID, rulesetVersion string) (metadata.RulesetDetailed, error) {
+	providerOption, ok := providerOptions[providerID]
+	if !ok {
+		return metadata.RulesetDetailed{}, fmt.Errorf("unknown provider: %s", providerID)
+	}
+
+	if providerOption.MetadataFunc == nil || providerOption.RulesFromConfigFunc == nil {
+		return metadata.RulesetDetailed{}, fmt.Errorf("provider %s does not support inspecting its rulesets", providerID)
+	}
+
+	providerMetadata := providerOption.MetadataFunc()
+	idx := slices.IndexFunc(providerMetadata.Rulesets, func(r metadata.Ruleset) bool { return r.ID == rulesetID })
+	if idx < 0 {
+		return metadata.RulesetDetailed{}, fmt.Errorf("unknown ruleset for provider %s: %s", providerID, rulesetID)
+	}
+	rulesetInfo := providerMetadata.Rulesets[idx]
+
+	if !slices.ContainsFunc(rulesetInfo.Versions, func(v metadata.Version) bool { return v.Version == rulesetVersion }) {
+		return metadata.RulesetDetailed{}, fmt.Errorf("unknown version of ruleset %s: %s", rulesetID, rulesetVersion)
+	}
+
+	var rulesetArgsTypes provider.RulesetArgsTypes
+	if providerOption.ArgsTypesFunc != nil {
+		rulesetArgsTypes = providerOption.ArgsTypesFunc().Rulesets[rulesetID][rulesetVersion]
+	}
+
+	rules, err := providerOption.RulesFromConfigFunc(config.RulesetConfig{
+		ID:      rulesetID,
+		Version: rulesetVersion,
+		Args:    rulesetArgsTypes.InspectArgs,
+	})
+	if err != nil {
+		return metadata.RulesetDetailed{}, err
+	}
+
+	rulesetMetadata := metadata.RulesetDetailed{
+		ID:      rulesetID,
+		Name:    rulesetInfo.Name,
+		Version: rulesetVersion,
+		Rules:   make([]metadata.Rule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		var argsSchema json.RawMessage
+		if ruleArgs, ok := rulesetArgsTypes.RuleArgs[r.ID()]; ok {
+			if argsSchema, err = json.Marshal(schema.For(ruleArgs)); err != nil {
+				return metadata.RulesetDetailed{}, err
+			}
+		}
+		rulesetMetadata.Rules = append(rulesetMetadata.Rules, metadata.NewRule(r, argsSchema))
+	}
+	return rulesetMetadata, nil
+}
+
+func ruleStatus(r metadata.Rule) string {
+	if r.Implemented {
+		return "Implemented"
+	}
+	return string(r.Status)
+}
+
+func printJSON(v any) error {
+	bytes, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+	return nil
+}
+
 func generateDiffCmd(args []string, generateDiffOpts generateDiffOptions, rootOpts reportOptions, logger *slog.Logger) error {
 	if len(args) == 0 {
 		return errors.New("generate diff command requires a minimum of one filepath argument")
@@ -264,82 +672,31 @@ func generateDiffCmd(args []string, generateDiffOpts generateDiffOptions, rootOp
 		writer = file
 	}
 
-	htmlRenderer, err := report.NewHTMLRenderer()
+	var (
+		renderer report.Renderer
+		err      error
+	)
+	switch generateDiffOpts.format {
+	case "html":
+		renderer, err = report.NewHTMLRenderer()
+	case "markdown":
+		renderer, err = report.NewMarkdownRenderer(0)
+	default:
+		return fmt.Errorf("not supported output format %s. Choose one of 'html' or 'markdown'", generateDiffOpts.format)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to initialize renderer: %w", err)
 	}
 
-	return htmlRenderer.Render(writer, &report.DifferenceReportsWrapper{
+	return renderer.Render(writer, &report.DifferenceReportsWrapper{
 		DifferenceReports:  differences,
 		IdentityAttributes: generateDiffOpts.identityAttributes,
 	})
 }
 
-func diffCmd(rootOpts reportOptions, opts diffOptions) error {
-	if len(opts.oldReport) == 0 && len(opts.newReport) == 0 {
-		return errors.New("diff command requires at least 1 report path")
-	}
-
-	var (
-		oldReport report.Report
-		newReport report.Report
-	)
-
-	if len(opts.oldReport) > 0 {
-		oldReportfileData, err := os.ReadFile(filepath.Clean(opts.oldReport))
-		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", opts.oldReport, err)
-		}
-
-		if err := json.Unmarshal(oldReportfileData, &oldReport); err != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", err)
-		}
-	}
-
-	if len(opts.newReport) > 0 {
-		newReportfileData, err := os.ReadFile(filepath.Clean(opts.newReport))
-		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", opts.newReport, err)
-		}
-
-		if err := json.Unmarshal(newReportfileData, &newReport); err != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", err)
-		}
-	}
-
-	diff, err := report.CreateDifference(oldReport, newReport, opts.title)
-	if err != nil {
-		return fmt.Errorf("failed to create diff: %w", err)
-	}
-
-	jsonDiff, err := json.Marshal(diff)
-	if err != nil {
-		return fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-
-	if len(rootOpts.outputPath) > 0 {
-		return os.WriteFile(rootOpts.outputPath, jsonDiff, 0600)
-	}
-
-	fmt.Print(string(jsonDiff))
-	return nil
-}
-
-func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, logger *slog.Logger) error {
+func trendCmd(args []string, rootOpts reportOptions, opts trendOptions, logger *slog.Logger) error {
 	if len(args) == 0 {
-		return errors.New("generate command requires a minimum of one filepath argument")
-	}
-
-	if len(args) > 1 && len(opts.distinctBy) == 0 {
-		return errors.New("generate command requires a single filepath argument when the distinct-by flag is not set")
-	}
-
-	minStatus := rule.Passed
-	if len(opts.minStatus) != 0 {
-		minStatus = rule.Status(opts.minStatus)
-		if !slices.Contains(rule.Statuses(), minStatus) {
-			return fmt.Errorf("not defined status: %s", minStatus)
-		}
+		return errors.New("trend command requires a minimum of one filepath argument")
 	}
 
 	var reports []*report.Report
@@ -349,16 +706,19 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 			return fmt.Errorf("failed to read file %s: %w", arg, err)
 		}
 
-		// TODO: handle report types
 		rep := &report.Report{}
 		if err := json.Unmarshal(fileData, rep); err != nil {
 			return fmt.Errorf("failed to unmarshal data: %w", err)
 		}
 
-		rep.SetMinStatus(minStatus)
 		reports = append(reports, rep)
 	}
 
+	trend, err := report.CreateTrend(reports, opts.identityAttributes)
+	if err != nil {
+		return fmt.Errorf("failed to create trend: %w", err)
+	}
+
 	var writer io.Writer = os.Stdout
 	if len(rootOpts.outputPath) > 0 {
 		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
@@ -373,18 +733,6 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 		writer = file
 	}
 
-	var outputReport any
-	outputReport = reports[0]
-
-	if len(opts.distinctBy) > 0 {
-		mergedReport, err := report.MergeReport(reports, opts.distinctBy)
-		if err != nil {
-			return err
-		}
-
-		outputReport = mergedReport
-	}
-
 	switch opts.format {
 	case "html":
 		htmlRenderer, err := report.NewHTMLRenderer()
@@ -392,9 +740,9 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 			return fmt.Errorf("failed to initialize renderer: %w", err)
 		}
 
-		return htmlRenderer.Render(writer, outputReport)
+		return htmlRenderer.Render(writer, trend)
 	case "json":
-		data, err := json.Marshal(outputReport)
+		data, err := json.Marshal(trend)
 		if err != nil {
 			return err
 		}
@@ -406,14 +754,415 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 	}
 }
 
-func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts runOptions, logger *slog.Logger) error {
-	// Set logger for controller-runtime clients
-	logr := slogr.NewLogr(logger)
-	logf.SetLogger(logr)
+func assembleCmd(args []string, rootOpts reportOptions, opts assembleOptions) error {
+	if len(args) == 0 {
+		return errors.New("assemble command requires a minimum of one filepath argument")
+	}
 
-	dikiConfig, err := readConfig(opts.configFile)
-	if err != nil {
-		return err
+	var reportOpts []report.ReportOption
+	if len(opts.configFile) > 0 {
+		dikiConfig, err := readConfig(opts.configFile)
+		if err != nil {
+			return err
+		}
+		reportOpts = reportOptionsFromConfig(dikiConfig)
+	}
+
+	var records []report.RuleResultRecord
+	for _, arg := range args {
+		fileRecords, err := readStreamFile(arg)
+		if err != nil {
+			return err
+		}
+		records = append(records, fileRecords...)
+	}
+
+	if len(records) == 0 {
+		return errors.New("no rule results found in the given files")
+	}
+
+	rep := report.FromRecords(records, reportOpts...)
+	if len(rootOpts.outputPath) > 0 {
+		return rep.WriteToFile(rootOpts.outputPath)
+	}
+
+	data, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(string(data))
+	return nil
+}
+
+func readStreamFile(filePath string) ([]report.RuleResultRecord, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	records, err := report.ReadStream(bytes.NewReader(fileData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream file %s: %w", filePath, err)
+	}
+	return records, nil
+}
+
+func readBaselineFile(filePath string) (*baseline.Baseline, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	b, err := baseline.Parse(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baseline file %s: %w", filePath, err)
+	}
+	return b, nil
+}
+
+func readSigner(filePath string) (*report.Signer, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	signer, err := report.NewSigner(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %w", filePath, err)
+	}
+	return signer, nil
+}
+
+func readVerifier(filePath string) (*report.Verifier, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	verifier, err := report.NewVerifier(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trust file %s: %w", filePath, err)
+	}
+	return verifier, nil
+}
+
+func readSignatureFile(filePath string) (*report.Signature, error) {
+	fileData, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	signature := &report.Signature{}
+	if err := json.Unmarshal(fileData, signature); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signature file %s: %w", filePath, err)
+	}
+	return signature, nil
+}
+
+// signaturePath returns the path of the signature file of the given report.
+func signaturePath(reportPath, signaturePath string) string {
+	if len(signaturePath) > 0 {
+		return signaturePath
+	}
+	return reportPath + report.SignatureFileSuffix
+}
+
+func verifyCmd(args []string, opts verifyOptions) error {
+	if len(args) != 1 {
+		return errors.New("verify command requires a single filepath argument")
+	}
+
+	if len(opts.trustPath) == 0 {
+		return errors.New("--trust must be set")
+	}
+
+	verifier, err := readVerifier(opts.trustPath)
+	if err != nil {
+		return err
+	}
+
+	signature, err := readSignatureFile(signaturePath(args[0], opts.signaturePath))
+	if err != nil {
+		return err
+	}
+
+	fileData, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	signer, err := verifier.Verify(fileData, signature)
+	if err != nil {
+		return fmt.Errorf("failed to verify signature of report %s: %w", args[0], err)
+	}
+
+	fmt.Printf("Verified signature of report %s signed by %s\n", args[0], signer)
+	return nil
+}
+
+func snapshotCmd(ctx context.Context, opts snapshotOptions, logger *slog.Logger) error {
+	if len(opts.outputPath) == 0 {
+		return errors.New("--output must be set")
+	}
+
+	// Set logger for controller-runtime clients
+	logf.SetLogger(slogr.NewLogr(logger))
+
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+	if len(opts.kubeconfigPath) > 0 {
+		restConfig, err = kubeutils.RESTConfigFromFile(opts.kubeconfigPath)
+	} else {
+		restConfig, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(opts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	snapshotMetadata := snapshot.Metadata{Time: time.Now().UTC(), Host: restConfig.Host}
+	if err := snapshot.Write(ctx, c, snapshotMetadata, file); err != nil {
+		return errors.Join(fmt.Errorf("failed to write snapshot: %w", err), file.Close(), os.Remove(opts.outputPath))
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	logger.Info("snapshot written", "path", opts.outputPath, "host", restConfig.Host)
+	return nil
+}
+
+func diffCmd(rootOpts reportOptions, opts diffOptions) error {
+	if len(opts.oldReport) == 0 && len(opts.newReport) == 0 {
+		return errors.New("diff command requires at least 1 report path")
+	}
+
+	var (
+		oldReport report.Report
+		newReport report.Report
+	)
+
+	if len(opts.oldReport) > 0 {
+		oldReportfileData, err := os.ReadFile(filepath.Clean(opts.oldReport))
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", opts.oldReport, err)
+		}
+
+		if err := json.Unmarshal(oldReportfileData, &oldReport); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+	}
+
+	if len(opts.newReport) > 0 {
+		newReportfileData, err := os.ReadFile(filepath.Clean(opts.newReport))
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", opts.newReport, err)
+		}
+
+		if err := json.Unmarshal(newReportfileData, &newReport); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+	}
+
+	diff, err := report.CreateDifference(oldReport, newReport, opts.title)
+	if err != nil {
+		return fmt.Errorf("failed to create diff: %w", err)
+	}
+
+	var output []byte
+	switch opts.format {
+	case "json":
+		if output, err = json.Marshal(diff); err != nil {
+			return fmt.Errorf("failed to marshal data: %w", err)
+		}
+	case "summary":
+		if output, err = json.Marshal(diff.Summary()); err != nil {
+			return fmt.Errorf("failed to marshal data: %w", err)
+		}
+	case "text":
+		var buf bytes.Buffer
+		if err := report.NewDifferenceTextRenderer().Render(&buf, diff); err != nil {
+			return err
+		}
+		output = buf.Bytes()
+	default:
+		return fmt.Errorf("not supported output format %s. Choose one of 'json', 'text' or 'summary'", opts.format)
+	}
+
+	if len(rootOpts.outputPath) > 0 {
+		if err := os.WriteFile(rootOpts.outputPath, output, 0600); err != nil {
+			return err
+		}
+	} else {
+		fmt.Print(string(output))
+	}
+
+	if !opts.exitCode {
+		return nil
+	}
+	return checkRegressions(diff.Summary())
+}
+
+// checkRegressions returns an error wrapping [ErrRegressions] when checks with status Failed or Errored were added.
+func checkRegressions(summary *report.DifferenceSummary) error {
+	var added []string
+	for _, status := range []rule.Status{rule.Failed, rule.Errored} {
+		if num := summary.Added[status]; num > 0 {
+			added = append(added, fmt.Sprintf("%dx %s", num, status))
+		}
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrRegressions, strings.Join(added, ", "))
+}
+
+func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, logger *slog.Logger) error {
+	if len(args) == 0 {
+		return errors.New("generate command requires a minimum of one filepath argument")
+	}
+
+	if len(args) > 1 && len(opts.distinctBy) == 0 {
+		return errors.New("generate command requires a single filepath argument when the distinct-by flag is not set")
+	}
+
+	minStatus := rule.Passed
+	if len(opts.minStatus) != 0 {
+		minStatus = rule.Status(opts.minStatus)
+		if !slices.Contains(rule.Statuses(), minStatus) {
+			return fmt.Errorf("not defined status: %s", minStatus)
+		}
+	}
+
+	var verifier *report.Verifier
+	if len(opts.trustPath) > 0 {
+		if opts.format != "html" || len(opts.distinctBy) > 0 {
+			return errors.New("--trust is only supported for single reports in 'html' format")
+		}
+
+		var err error
+		if verifier, err = readVerifier(opts.trustPath); err != nil {
+			return err
+		}
+	}
+
+	var (
+		reports      []*report.Report
+		verification *report.Verification
+	)
+	for _, arg := range args {
+		fileData, err := os.ReadFile(filepath.Clean(arg))
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", arg, err)
+		}
+
+		if verifier != nil {
+			signature, err := readSignatureFile(signaturePath(arg, opts.signaturePath))
+			if err != nil {
+				return err
+			}
+			verification = verifier.VerifySignature(fileData, signature)
+		}
+
+		// TODO: handle report types
+		rep := &report.Report{}
+		if err := json.Unmarshal(fileData, rep); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+
+		rep.SetMinStatus(minStatus)
+		reports = append(reports, rep)
+	}
+
+	var writer io.Writer = os.Stdout
+	if len(rootOpts.outputPath) > 0 {
+		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				logger.Error(err.Error())
+			}
+		}()
+		writer = file
+	}
+
+	var outputReport any
+	outputReport = reports[0]
+
+	if len(opts.distinctBy) > 0 {
+		mergedReport, err := report.MergeReport(reports, opts.distinctBy)
+		if err != nil {
+			return err
+		}
+
+		outputReport = mergedReport
+	}
+
+	switch opts.format {
+	case "html":
+		htmlRenderer, err := report.NewHTMLRenderer(report.WithVerification(verification))
+		if err != nil {
+			return fmt.Errorf("failed to initialize renderer: %w", err)
+		}
+
+		return htmlRenderer.Render(writer, outputReport)
+	case "markdown":
+		markdownRenderer, err := report.NewMarkdownRenderer(opts.maxTargets)
+		if err != nil {
+			return fmt.Errorf("failed to initialize renderer: %w", err)
+		}
+
+		return markdownRenderer.Render(writer, outputReport)
+	case "sarif":
+		return report.NewSARIFRenderer().Render(writer, outputReport)
+	case "junit":
+		return report.NewJUnitRenderer().Render(writer, outputReport)
+	case "csv":
+		return report.NewCSVRenderer().Render(writer, outputReport)
+	case "xlsx":
+		return report.NewXLSXRenderer().Render(writer, outputReport)
+	case "json":
+		data, err := json.Marshal(outputReport)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(data)
+		return err
+	default:
+		return fmt.Errorf("not supported output format %s. Choose one of 'html', 'markdown', 'json', 'sarif', 'junit', 'csv' or 'xlsx'", opts.format)
+	}
+}
+
+func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts runOptions, logger *slog.Logger) error {
+	// Set logger for controller-runtime clients
+	logr := slogr.NewLogr(logger)
+	logf.SetLogger(logr)
+
+	failOn, failOnSeverity, err := parseFailThresholds(opts.failOn, opts.failOnSeverity)
+	if err != nil {
+		return err
+	}
+
+	dikiConfig, err := readConfig(opts.configFile)
+	if err != nil {
+		return err
 	}
 
 	outputPath := opts.outputPath
@@ -421,33 +1170,119 @@ func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.Provide
 		outputPath = dikiConfig.Output.Path
 	}
 
+	var signer *report.Signer
+	if len(opts.signKeyPath) > 0 {
+		if len(outputPath) == 0 {
+			return errors.New("--sign-key requires an output path")
+		}
+
+		if signer, err = readSigner(opts.signKeyPath); err != nil {
+			return err
+		}
+	}
+
+	if dikiConfig.NumWorkers < 0 {
+		return errors.New("numWorkers should not be a negative number")
+	}
+
+	if dikiConfig.Timeout < 0 {
+		return errors.New("timeout should not be a negative duration")
+	}
+
+	if dikiConfig.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dikiConfig.Timeout)
+		defer cancel()
+	}
+
 	providers, err := getProvidersFromConfig(dikiConfig, providerCreateFuncs)
 	if err != nil {
 		return err
 	}
 
-	if opts.all {
-		var providerResults []provider.ProviderResult
-		for _, p := range providers {
-			res, err := p.RunAll(ctx)
-			if err != nil {
-				return err
-			}
-			providerResults = append(providerResults, res)
+	ruleFilter, err := ruleFilterFromOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	ruleMatcher, err := ruleFilter.Matcher()
+	if err != nil {
+		return fmt.Errorf("invalid rule filter: %w", err)
+	}
+
+	var hookFuncs []func(p provider.Provider, hooks *ruleset.RunHooks)
+	providerHooks := func(p provider.Provider) ruleset.RunHooks {
+		var hooks ruleset.RunHooks
+		for _, hookFunc := range hookFuncs {
+			hookFunc(p, &hooks)
+		}
+		return hooks
+	}
+
+	if !ruleFilter.IsEmpty() {
+		hookFuncs = append(hookFuncs, func(_ provider.Provider, hooks *ruleset.RunHooks) {
+			hooks.Matcher = ruleMatcher
+		})
+	}
+
+	baselinePath := opts.baselinePath
+	if len(baselinePath) == 0 {
+		baselinePath = dikiConfig.Baseline
+	}
+
+	if len(baselinePath) > 0 {
+		b, err := readBaselineFile(baselinePath)
+		if err != nil {
+			return err
 		}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+		now := time.Now()
+		hookFuncs = append(hookFuncs, func(p provider.Provider, hooks *ruleset.RunHooks) {
+			hooks.Modifier = b.Modifier(p.ID(), now)
+		})
+	}
+
+	if len(opts.resumePath) > 0 {
+		records, err := readStreamFile(opts.resumePath)
+		if err != nil {
+			return err
+		}
+
+		resumeState := report.NewResumeState(records)
+		hookFuncs = append(hookFuncs, func(p provider.Provider, hooks *ruleset.RunHooks) {
+			hooks.Resumed = resumeState.RuleResults(p.ID())
+		})
+	}
+
+	if len(opts.streamOutputPath) > 0 {
+		file, err := report.OpenStreamFile(opts.streamOutputPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				logger.Error(err.Error())
 			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+		}()
+
+		streamWriter := report.NewStreamWriter(file)
+		hookFuncs = append(hookFuncs, func(p provider.Provider, hooks *ruleset.RunHooks) {
+			hooks.Handler = func(result ruleset.RulesetResult) {
+				if err := streamWriter.Write(report.RecordsFromRulesetResult(p, result)...); err != nil {
+					logger.Error("failed to write rule result to stream output", "provider", p.ID(), "ruleset", result.RulesetID, "version", result.RulesetVersion, "error", err)
+				}
 			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
-		}
-		return nil
+		})
+	}
+
+	var (
+		providerResults []provider.ProviderResult
+		runErr          error
+	)
+
+	if opts.all {
+		providerResults, runErr = runProviders(ctx, providers, dikiConfig.NumWorkers, providerHooks)
+		return finishRun(providerResults, runErr, dikiConfig, outputPath, signer, ruleFilter, failOn, failOnSeverity)
 	}
 
 	p, ok := providers[opts.provider]
@@ -458,68 +1293,214 @@ func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.Provide
 	switch {
 	case opts.rulesetID == "" && opts.rulesetVersion == "":
 		// run all rulesets for the provider
-		res, err := p.RunAll(ctx)
-		if err != nil {
-			return err
+		res, err := p.RunAll(ctx, providerHooks(p))
+		if len(res.RulesetResults) > 0 {
+			providerResults = append(providerResults, res)
 		}
-		providerResults := []provider.ProviderResult{res}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
-			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
-			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
-		}
-		return nil
+		return finishRun(providerResults, err, dikiConfig, outputPath, signer, ruleFilter, failOn, failOnSeverity)
 	case opts.rulesetID != "" && opts.rulesetVersion == "":
 		return errors.New("--ruleset-version should be set along with --ruleset-id")
 	case opts.rulesetID == "" && opts.rulesetVersion != "":
 		return errors.New("--ruleset-id should be set along with --ruleset-version")
 	}
 
-	if opts.ruleID == "" {
-		// run the whole ruleset
-		res, err := p.RunRuleset(ctx, opts.rulesetID, opts.rulesetVersion)
-		if err != nil {
-			return err
-		}
-		providerResults := []provider.ProviderResult{{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}}}
+	// run the whole ruleset or the rules selected by the rule filter
+	res, err := p.RunRuleset(ctx, opts.rulesetID, opts.rulesetVersion, providerHooks(p))
+	if len(res.RuleResults) > 0 {
+		providerResults = append(providerResults, provider.ProviderResult{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}})
+	}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+	return finishRun(providerResults, err, dikiConfig, outputPath, signer, ruleFilter, failOn, failOnSeverity)
+}
+
+// ruleFilterFromOptions returns the rule filter set by the run flags.
+// The --rule-id flag selects a single rule of the ruleset set by --ruleset-id and --ruleset-version.
+// It does not accept glob patterns.
+func ruleFilterFromOptions(opts runOptions) (ruleset.RuleFilter, error) {
+	ruleFilter := ruleset.RuleFilter{
+		Rules:        opts.rules,
+		ExcludeRules: opts.excludeRules,
+		MinSeverity:  rule.SeverityLevel(opts.minSeverity),
+	}
+
+	if len(opts.ruleID) == 0 {
+		return ruleFilter, nil
+	}
+
+	switch {
+	case strings.ContainsAny(opts.ruleID, `*?[\`):
+		return ruleset.RuleFilter{}, errors.New("--rule-id must be a rule id, use --rules to select rules by a pattern")
+	case len(opts.rules) > 0:
+		return ruleset.RuleFilter{}, errors.New("--rule-id cannot be used together with --rules")
+	case opts.all || len(opts.rulesetID) == 0 || len(opts.rulesetVersion) == 0:
+		return ruleset.RuleFilter{}, errors.New("--rule-id should be set along with --provider, --ruleset-id and --ruleset-version")
+	}
+
+	ruleFilter.Rules = []string{opts.ruleID}
+	return ruleFilter, nil
+}
+
+// runProviders runs all rulesets of the given providers.
+// Up to numWorkers providers are run concurrently.
+// Each provider is run with the hooks returned by providerHooks.
+func runProviders(
+	ctx context.Context,
+	providers map[string]provider.Provider,
+	numWorkers int,
+	providerHooks func(provider.Provider) ruleset.RunHooks,
+) ([]provider.ProviderResult, error) {
+	var (
+		providerResults []provider.ProviderResult
+		errAgg          error
+		mu              sync.Mutex
+		wg              sync.WaitGroup
+		workers         = make(chan struct{}, max(numWorkers, 1))
+	)
+
+	for _, p := range providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			res, err := p.RunAll(ctx, providerHooks(p))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errAgg = errors.Join(errAgg, fmt.Errorf("provider with id %s errored: %w", p.ID(), err))
+			}
+			if len(res.RulesetResults) > 0 {
+				providerResults = append(providerResults, res)
 			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+		}()
+	}
+	wg.Wait()
+
+	// sort providers to ensure static order regardless of the order in which they finished
+	slices.SortFunc(providerResults, func(a, b provider.ProviderResult) int {
+		return cmp.Compare(a.ProviderID, b.ProviderID)
+	})
+	return providerResults, errAgg
+}
+
+// finishRun writes the report of a run and returns the run error if present.
+// Otherwise it checks the results against the configured fail thresholds.
+func finishRun(
+	providerResults []provider.ProviderResult,
+	runErr error,
+	dikiConfig *config.DikiConfig,
+	outputPath string,
+	signer *report.Signer,
+	ruleFilter ruleset.RuleFilter,
+	failOn rule.Status,
+	failOnSeverity rule.SeverityLevel,
+) error {
+	if err := writeReport(providerResults, dikiConfig, outputPath, signer, report.RuleFilter(ruleFilter)); err != nil {
+		return errors.Join(runErr, err)
+	}
+
+	if runErr != nil {
+		return runErr
+	}
+
+	if len(providerResults) == 0 && !ruleFilter.IsEmpty() {
+		return fmt.Errorf("no rules match the rule filter %s", ruleFilter)
+	}
+
+	var ruleResults []rule.RuleResult
+	for _, providerResult := range providerResults {
+		for _, rulesetResult := range providerResult.RulesetResults {
+			ruleResults = append(ruleResults, rulesetResult.RuleResults...)
+		}
+	}
+	return checkFindings(ruleResults, failOn, failOnSeverity)
+}
+
+// parseFailThresholds validates the --fail-on and --fail-on-severity flag values.
+// When only a severity threshold is set the status threshold defaults to [rule.Failed].
+func parseFailThresholds(failOn, failOnSeverity string) (rule.Status, rule.SeverityLevel, error) {
+	var (
+		status   = rule.Status(failOn)
+		severity = rule.SeverityLevel(failOnSeverity)
+	)
+
+	if len(status) > 0 && !slices.Contains(rule.Statuses(), status) {
+		return "", "", fmt.Errorf("not defined status for --fail-on: %s", status)
+	}
+
+	if len(severity) > 0 {
+		if !slices.Contains(rule.SeverityLevels(), severity) {
+			return "", "", fmt.Errorf("not defined severity for --fail-on-severity: %s", severity)
+		}
+		if len(status) == 0 {
+			status = rule.Failed
+		}
+	}
+
+	return status, severity, nil
+}
+
+// checkFindings returns an error wrapping [ErrFindings] when a check has a status
+// at or above failOn and belongs to a rule with a severity at or above failOnSeverity.
+// Checks of rules that are not implemented are findings only when failOn is [rule.NotImplemented].
+// Checks are not evaluated when failOn is empty.
+func checkFindings(ruleResults []rule.RuleResult, failOn rule.Status, failOnSeverity rule.SeverityLevel) error {
+	if len(failOn) == 0 {
+		return nil
+	}
+
+	var ruleIDs []string
+	for _, ruleResult := range ruleResults {
+		if len(failOnSeverity) > 0 && ruleResult.Severity.Less(failOnSeverity) {
+			continue
+		}
+
+		if slices.ContainsFunc(ruleResult.CheckResults, func(checkResult rule.CheckResult) bool {
+			if checkResult.Status == rule.NotImplemented {
+				return failOn == rule.NotImplemented
 			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
+			return !checkResult.Status.Less(failOn)
+		}) {
+			ruleIDs = append(ruleIDs, ruleResult.RuleID)
 		}
+	}
+
+	if len(ruleIDs) == 0 {
 		return nil
 	}
 
-	return runRule(ctx, p, opts.rulesetID, opts.rulesetVersion, opts.ruleID)
+	slices.Sort(ruleIDs)
+	ruleIDs = slices.Compact(ruleIDs)
+	return fmt.Errorf("%w: rules %v have checks with status %s or higher", ErrFindings, ruleIDs, failOn)
 }
 
-func runRule(ctx context.Context, p provider.Provider, rulesetID, rulesetVersion, ruleID string) error {
-	res, err := p.RunRule(ctx, rulesetID, rulesetVersion, ruleID)
-	if err != nil {
-		return err
+// writeReport writes a report built from the given provider results to outputPath and
+// its signature if a signer is set. Nothing is written when outputPath is empty or there are no results.
+func writeReport(providerResults []provider.ProviderResult, dikiConfig *config.DikiConfig, outputPath string, signer *report.Signer, options ...report.ReportOption) error {
+	if len(outputPath) == 0 || len(providerResults) == 0 {
+		return nil
 	}
 
-	j, err := json.Marshal(res)
-	if err != nil {
-		return err
+	rep := report.FromProviderResults(providerResults, append(reportOptionsFromConfig(dikiConfig), options...)...)
+	if signer != nil {
+		return rep.WriteSignedToFile(outputPath, signer)
 	}
+	return rep.WriteToFile(outputPath)
+}
 
-	fmt.Print(string(j))
-	return nil
+// reportOptionsFromConfig returns the report options set in the given config.
+func reportOptionsFromConfig(dikiConfig *config.DikiConfig) []report.ReportOption {
+	var reportOpts []report.ReportOption
+	if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
+		reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+	}
+	if len(dikiConfig.Metadata) > 0 {
+		reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+	}
+	return reportOpts
 }
 
 type reportOptions struct {
@@ -527,29 +1508,77 @@ type reportOptions struct {
 }
 
 type runOptions struct {
+	outputPath       string
+	configFile       string
+	all              bool
+	provider         string
+	rulesetID        string
+	rulesetVersion   string
+	ruleID           string
+	failOn           string
+	failOnSeverity   string
+	streamOutputPath string
+	resumePath       string
+	baselinePath     string
+	signKeyPath      string
+	rules            []string
+	excludeRules     []string
+	minSeverity      string
+}
+
+type generateOptions struct {
+	distinctBy    map[string]string
+	format        string
+	minStatus     string
+	maxTargets    int
+	trustPath     string
+	signaturePath string
+}
+
+type generateDiffOptions struct {
+	identityAttributes map[string]string
+	format             string
+}
+
+type snapshotOptions struct {
+	kubeconfigPath string
 	outputPath     string
-	configFile     string
-	all            bool
+}
+
+type validateOptions struct {
+	configFile string
+}
+
+type showSchemaOptions struct {
 	provider       string
 	rulesetID      string
 	rulesetVersion string
-	ruleID         string
 }
 
-type generateOptions struct {
-	distinctBy map[string]string
-	format     string
-	minStatus  string
+type showRulesetOptions struct {
+	format string
 }
 
-type generateDiffOptions struct {
+type trendOptions struct {
+	format             string
 	identityAttributes map[string]string
 }
 
+type verifyOptions struct {
+	trustPath     string
+	signaturePath string
+}
+
+type assembleOptions struct {
+	configFile string
+}
+
 type diffOptions struct {
 	oldReport string
 	newReport string
 	title     string
+	format    string
+	exitCode  bool
 }
 
 func readConfig(filePath string) (*config.DikiConfig, error) {
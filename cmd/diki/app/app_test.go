@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/cmd/diki/app"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("app", func() {
+	DescribeTable("#ExitCode",
+		func(err error, expectedExitCode int) {
+			Expect(app.ExitCode(err)).To(Equal(expectedExitCode))
+		},
+		Entry("no error", nil, 0),
+		Entry("error", errors.New("foo"), app.ExitCodeError),
+		Entry("findings", app.ErrFindings, app.ExitCodeFindings),
+		Entry("wrapped findings", fmt.Errorf("%w: rules [1]", app.ErrFindings), app.ExitCodeFindings),
+		Entry("wrapped regressions", fmt.Errorf("%w: 1x Failed", app.ErrRegressions), app.ExitCodeFindings),
+		Entry("findings joined with regressions", errors.Join(app.ErrFindings, app.ErrRegressions), app.ExitCodeFindings),
+		Entry("findings joined with an error", errors.Join(fmt.Errorf("%w: rules [1]", app.ErrFindings), errors.New("foo")), app.ExitCodeError),
+		Entry("wrapped findings joined with an error", fmt.Errorf("bar: %w", errors.Join(app.ErrFindings, errors.New("foo"))), app.ExitCodeError),
+	)
+
+	DescribeTable("#ParseFailThresholds",
+		func(failOn, failOnSeverity string, expectedStatus rule.Status, expectedSeverity rule.SeverityLevel, expectedErr string) {
+			status, severity, err := app.ParseFailThresholds(failOn, failOnSeverity)
+			if len(expectedErr) > 0 {
+				Expect(err).To(MatchError(expectedErr))
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(expectedStatus))
+			Expect(severity).To(Equal(expectedSeverity))
+		},
+		Entry("no thresholds", "", "", rule.Status(""), rule.SeverityLevel(""), ""),
+		Entry("status threshold", "Warning", "", rule.Warning, rule.SeverityLevel(""), ""),
+		Entry("status and severity thresholds", "Errored", "High", rule.Errored, rule.SeverityHigh, ""),
+		Entry("severity threshold defaults status to Failed", "", "Medium", rule.Failed, rule.SeverityMedium, ""),
+		Entry("unknown status", "Foo", "", rule.Status(""), rule.SeverityLevel(""), "not defined status for --fail-on: Foo"),
+		Entry("unknown severity", "Failed", "Foo", rule.Status(""), rule.SeverityLevel(""), "not defined severity for --fail-on-severity: Foo"),
+	)
+
+	Describe("#CheckFindings", func() {
+		var ruleResults []rule.RuleResult
+
+		BeforeEach(func() {
+			ruleResults = []rule.RuleResult{
+				{RuleID: "3", Severity: rule.SeverityHigh, CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", nil), rule.FailedCheckResult("foo", nil)}},
+				{RuleID: "2", Severity: rule.SeverityLow, CheckResults: []rule.CheckResult{rule.ErroredCheckResult("foo", nil)}},
+				{RuleID: "1", CheckResults: []rule.CheckResult{rule.WarningCheckResult("foo", nil)}},
+				{RuleID: "3", Severity: rule.SeverityHigh, CheckResults: []rule.CheckResult{rule.FailedCheckResult("bar", nil)}},
+				{RuleID: "4", Severity: rule.SeverityHigh, CheckResults: []rule.CheckResult{rule.NotImplementedCheckResult("foo", nil)}},
+			}
+		})
+
+		DescribeTable("should return findings at or above the thresholds",
+			func(failOn rule.Status, failOnSeverity rule.SeverityLevel, expectedErr string) {
+				err := app.CheckFindings(ruleResults, failOn, failOnSeverity)
+				if len(expectedErr) == 0 {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(expectedErr))
+				Expect(err).To(MatchError(app.ErrFindings))
+				Expect(app.ExitCode(err)).To(Equal(app.ExitCodeFindings))
+			},
+			Entry("no status threshold", rule.Status(""), rule.SeverityHigh, ""),
+			Entry("status threshold", rule.Failed, rule.SeverityLevel(""), "found checks at or above the fail threshold: rules [2 3] have checks with status Failed or higher"),
+			Entry("lower status threshold", rule.Warning, rule.SeverityLevel(""), "found checks at or above the fail threshold: rules [1 2 3] have checks with status Warning or higher"),
+			Entry("higher status threshold", rule.Errored, rule.SeverityLevel(""), "found checks at or above the fail threshold: rules [2] have checks with status Errored or higher"),
+			Entry("severity threshold", rule.Failed, rule.SeverityMedium, "found checks at or above the fail threshold: rules [3] have checks with status Failed or higher"),
+			Entry("no checks above the thresholds", rule.Errored, rule.SeverityHigh, ""),
+			Entry("not implemented status threshold", rule.NotImplemented, rule.SeverityLevel(""), "found checks at or above the fail threshold: rules [4] have checks with status Not Implemented or higher"),
+		)
+	})
+})
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+var (
+	ParseFailThresholds = parseFailThresholds
+	CheckFindings       = checkFindings
+)
@@ -6,29 +6,21 @@ package main
 
 import (
 	"log"
+	"os"
 
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	"github.com/gardener/diki/cmd/diki/app"
-	"github.com/gardener/diki/pkg/provider"
-	"github.com/gardener/diki/pkg/provider/builder"
-	"github.com/gardener/diki/pkg/provider/garden"
-	"github.com/gardener/diki/pkg/provider/gardener"
-	"github.com/gardener/diki/pkg/provider/managedk8s"
-	"github.com/gardener/diki/pkg/provider/virtualgarden"
+	// register the providers and rulesets of diki
+	_ "github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/registry"
 )
 
 func main() {
-	cmd := app.NewDikiCommand(
-		map[string]provider.ProviderOption{
-			garden.ProviderID:        {ProviderFromConfigFunc: builder.GardenProviderFromConfig, MetadataFunc: builder.GardenProviderMetadata},
-			gardener.ProviderID:      {ProviderFromConfigFunc: builder.GardenerProviderFromConfig, MetadataFunc: builder.GardenerProviderMetadata},
-			managedk8s.ProviderID:    {ProviderFromConfigFunc: builder.ManagedK8SProviderFromConfig, MetadataFunc: builder.ManagedK8SProviderMetadata},
-			virtualgarden.ProviderID: {ProviderFromConfigFunc: builder.VirtualGardenProviderFromConfig, MetadataFunc: builder.VirtualGardenProviderMetadata},
-		},
-	)
+	cmd := app.NewDikiCommand(registry.Default.ProviderOptions())
 
 	if err := cmd.ExecuteContext(controllerruntime.SetupSignalHandler()); err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		os.Exit(app.ExitCode(err))
 	}
 }
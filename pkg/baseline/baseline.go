@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package baseline
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// Baseline contains known findings that are accepted for all rulesets.
+type Baseline struct {
+	// Entries are the accepted findings.
+	Entries []Entry `yaml:"entries"`
+}
+
+// Entry accepts the findings of a rule whose targets match the entry.
+type Entry struct {
+	// ProviderID is the id of the provider. If empty the entry matches all providers.
+	ProviderID string `yaml:"providerID,omitempty"`
+	// RulesetID is the id of the ruleset. If empty the entry matches all rulesets.
+	RulesetID string `yaml:"rulesetID,omitempty"`
+	// RuleID is the id of the rule.
+	RuleID string `yaml:"ruleID"`
+	// Target contains the attributes that a target should have in order to be matched.
+	// Values can be glob patterns, e.g. "coredns-*". An empty target matches all targets.
+	Target map[string]string `yaml:"target,omitempty"`
+	// Justification represents the reason why the findings are accepted.
+	Justification string `yaml:"justification"`
+	// Exception contains the optional expiration date, owner and reference of the entry.
+	rule.Exception `yaml:",inline"`
+}
+
+// Parse parses and validates a baseline.
+func Parse(data []byte) (*Baseline, error) {
+	b := &Baseline{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(b); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	for i, entry := range b.Entries {
+		if len(entry.RuleID) == 0 {
+			return nil, fmt.Errorf("entries[%d]: ruleID must not be empty", i)
+		}
+		if len(strings.TrimSpace(entry.Justification)) == 0 {
+			return nil, fmt.Errorf("entries[%d]: justification must not be empty", i)
+		}
+		if _, err := entry.Expiration(); err != nil {
+			return nil, fmt.Errorf("entries[%d]: %w", i, err)
+		}
+		for key, pattern := range entry.Target {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("entries[%d]: invalid target pattern %s for key %s: %w", i, pattern, key, err)
+			}
+		}
+	}
+	return b, nil
+}
+
+// Modifier returns a [ruleset.RuleResultModifier] that converts the [rule.Failed] and
+// [rule.Warning] check results of the given provider which match an entry to [rule.Accepted]
+// ones with the entry's justification. Check results matched by an entry that has lapsed
+// at the given time are reported as [rule.Failed] with a message that the entry has lapsed.
+// Check results that were produced by other exceptions are not changed.
+func (b *Baseline) Modifier(providerID string, now time.Time) ruleset.RuleResultModifier {
+	return func(rulesetID, _ string, result rule.RuleResult) rule.RuleResult {
+		var entries []Entry
+		for _, entry := range b.Entries {
+			if entry.matchesRule(providerID, rulesetID, result.RuleID) {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			return result
+		}
+
+		result.CheckResults = slices.Clone(result.CheckResults)
+		for i, checkResult := range result.CheckResults {
+			if (checkResult.Status != rule.Failed && checkResult.Status != rule.Warning) || checkResult.Exception != nil {
+				continue
+			}
+			for _, entry := range entries {
+				if entry.matchesTarget(checkResult.Target) {
+					result.CheckResults[i] = entry.CheckResult(strings.TrimSpace(entry.Justification), checkResult.Target, now)
+					break
+				}
+			}
+		}
+		return result
+	}
+}
+
+func (e Entry) matchesRule(providerID, rulesetID, ruleID string) bool {
+	return (len(e.ProviderID) == 0 || e.ProviderID == providerID) &&
+		(len(e.RulesetID) == 0 || e.RulesetID == rulesetID) &&
+		e.RuleID == ruleID
+}
+
+func (e Entry) matchesTarget(target rule.Target) bool {
+	for key, pattern := range e.Target {
+		value, ok := target[key]
+		if !ok {
+			return false
+		}
+		if matched, _ := path.Match(pattern, value); !matched {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package baseline_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/baseline"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("baseline", func() {
+	Describe("#Parse", func() {
+		It("should parse a baseline", func() {
+			b, err := baseline.Parse([]byte(`
+entries:
+- providerID: managedk8s
+  ruleID: "2000"
+  target:
+    name: coredns-*
+  justification: known
+  expiresAt: 2000-01-02
+`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b.Entries).To(Equal([]baseline.Entry{
+				{
+					ProviderID:    "managedk8s",
+					RuleID:        "2000",
+					Target:        map[string]string{"name": "coredns-*"},
+					Justification: "known",
+					Exception:     rule.Exception{ExpiresAt: "2000-01-02"},
+				},
+			}))
+		})
+
+		DescribeTable("should return error for invalid baselines",
+			func(data, expectedErr string) {
+				b, err := baseline.Parse([]byte(data))
+
+				Expect(b).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("missing rule id", "entries:\n- justification: known\n", "entries[0]: ruleID must not be empty"),
+			Entry("missing justification", "entries:\n- ruleID: \"2000\"\n", "entries[0]: justification must not be empty"),
+			Entry("invalid target pattern", "entries:\n- ruleID: \"2000\"\n  justification: known\n  target:\n    name: \"[\"\n", "entries[0]: invalid target pattern [ for key name"),
+			Entry("invalid expiration date", "entries:\n- ruleID: \"2000\"\n  justification: known\n  expiresAt: tomorrow\n", "entries[0]: invalid expiration date tomorrow"),
+			Entry("unknown field", "entries:\n- ruleId: \"2000\"\n", "field ruleId not found"),
+		)
+	})
+
+	Describe("#Modifier", func() {
+		var (
+			now    = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+			result rule.RuleResult
+		)
+
+		BeforeEach(func() {
+			result = rule.RuleResult{
+				RuleID: "2000",
+				CheckResults: []rule.CheckResult{
+					rule.FailedCheckResult("privileged", rule.NewTarget("kind", "Pod", "name", "coredns-1")),
+					rule.WarningCheckResult("privileged", rule.NewTarget("kind", "Pod", "name", "foo")),
+					rule.PassedCheckResult("not privileged", rule.NewTarget("kind", "Pod", "name", "coredns-2")),
+					rule.ErroredCheckResult("failed", rule.NewTarget("kind", "Pod", "name", "coredns-3")),
+				},
+			}
+		})
+
+		It("should accept the failed and warning checks that match an entry", func() {
+			b := &baseline.Baseline{Entries: []baseline.Entry{
+				{RuleID: "2000", Target: map[string]string{"name": "coredns-*"}, Justification: "known"},
+			}}
+
+			modified := b.Modifier("managedk8s", now)("security-hardened-k8s", "v1", result)
+
+			Expect(modified.CheckResults).To(Equal([]rule.CheckResult{
+				rule.AcceptedCheckResult("known", rule.NewTarget("kind", "Pod", "name", "coredns-1")),
+				rule.WarningCheckResult("privileged", rule.NewTarget("kind", "Pod", "name", "foo")),
+				rule.PassedCheckResult("not privileged", rule.NewTarget("kind", "Pod", "name", "coredns-2")),
+				rule.ErroredCheckResult("failed", rule.NewTarget("kind", "Pod", "name", "coredns-3")),
+			}))
+			Expect(result.CheckResults[0].Status).To(Equal(rule.Failed))
+		})
+
+		It("should accept all targets when the entry has no target", func() {
+			b := &baseline.Baseline{Entries: []baseline.Entry{{RuleID: "2000", Justification: "known"}}}
+
+			modified := b.Modifier("managedk8s", now)("security-hardened-k8s", "v1", result)
+
+			Expect(modified.CheckResults[0].Status).To(Equal(rule.Accepted))
+			Expect(modified.CheckResults[1].Status).To(Equal(rule.Accepted))
+		})
+
+		DescribeTable("should not change results of entries that do not apply",
+			func(entry baseline.Entry) {
+				entry.Justification = "known"
+				b := &baseline.Baseline{Entries: []baseline.Entry{entry}}
+
+				Expect(b.Modifier("managedk8s", now)("security-hardened-k8s", "v1", result)).To(Equal(result))
+			},
+			Entry("other rule", baseline.Entry{RuleID: "2001"}),
+			Entry("other provider", baseline.Entry{ProviderID: "garden", RuleID: "2000"}),
+			Entry("other ruleset", baseline.Entry{RulesetID: "disa-kubernetes-stig", RuleID: "2000"}),
+			Entry("missing target key", baseline.Entry{RuleID: "2000", Target: map[string]string{"namespace": "*"}}),
+		)
+
+		It("should report the checks matched by lapsed entries as failed", func() {
+			b := &baseline.Baseline{Entries: []baseline.Entry{
+				{RuleID: "2000", Target: map[string]string{"name": "coredns-1"}, Justification: "known", Exception: rule.Exception{ExpiresAt: "2000-01-01", Owner: "team-foo"}},
+			}}
+			modify := b.Modifier("managedk8s", now)
+
+			modified := modify("security-hardened-k8s", "v1", result)
+
+			lapsed := rule.FailedCheckResult("Exception lapsed on 2000-01-01 (owner: team-foo): known", rule.NewTarget("kind", "Pod", "name", "coredns-1"))
+			lapsed.Exception = &rule.Exception{ExpiresAt: "2000-01-01", Owner: "team-foo"}
+			Expect(modified.CheckResults[0]).To(Equal(lapsed))
+			Expect(modify("security-hardened-k8s", "v1", modified)).To(Equal(modified))
+		})
+	})
+})
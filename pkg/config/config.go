@@ -4,6 +4,12 @@
 
 package config
 
+import (
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
 // DikiConfig is used to represent Diki configuration file.
 type DikiConfig struct {
 	// Providers is a list of all known providers.
@@ -12,6 +18,15 @@ type DikiConfig struct {
 	Metadata map[string]any `yaml:"metadata,omitempty"`
 	// Output describes options related to diki's output configuration.
 	Output *OutputConfig `yaml:"output,omitempty"`
+	// NumWorkers is the maximum number of providers that are run concurrently
+	// when all providers are run. Defaults to 1.
+	NumWorkers int `yaml:"numWorkers,omitempty"`
+	// Timeout is the deadline of the whole diki run, e.g. "2h".
+	// A zero value means no deadline.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	// Baseline is the path to a baseline file that lists known findings
+	// which are accepted for all providers and rulesets.
+	Baseline string `yaml:"baseline,omitempty"`
 }
 
 // ProviderConfig is used to describe and configure a provider.
@@ -26,6 +41,9 @@ type ProviderConfig struct {
 	Rulesets []RulesetConfig `yaml:"rulesets"`
 	// Args are provider specific arguments that each provider should be able to parse.
 	Args any `yaml:"args"`
+	// NumWorkers is the maximum number of rulesets of the provider
+	// that are run concurrently. Defaults to 1.
+	NumWorkers int `yaml:"numWorkers,omitempty"`
 }
 
 // RulesetConfig is used to describe and configure a ruleset.
@@ -40,6 +58,12 @@ type RulesetConfig struct {
 	RuleOptions []RuleOptionsConfig `yaml:"ruleOptions"`
 	// Args are ruleset specific arguments that each ruleset should be able to parse.
 	Args any `yaml:"args"`
+	// NumWorkers is the maximum number of rules of the ruleset
+	// that are run concurrently. Defaults to 5.
+	NumWorkers int `yaml:"numWorkers,omitempty"`
+	// Timeout is the deadline of the whole ruleset run, e.g. "1h".
+	// A zero value means no deadline.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
 }
 
 // RuleOptionsConfig represents per rule options.
@@ -50,6 +74,9 @@ type RuleOptionsConfig struct {
 	Skip *RuleOptionSkipConfig `yaml:"skip,omitempty"`
 	// Args are rule specific arguments that each rule should be able to parse.
 	Args any `yaml:"args,omitempty"`
+	// Timeout is the deadline of the rule run, e.g. "10m".
+	// A zero value means no deadline.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
 }
 
 // RuleOptionSkipConfig represents options allowing a rule skip.
@@ -58,6 +85,8 @@ type RuleOptionSkipConfig struct {
 	Enabled bool `yaml:"enabled"`
 	// Justification represents the reason why a rule is skipped.
 	Justification string `yaml:"justification"`
+	// Exception contains the optional expiration date, owner and reference of the skip.
+	rule.Exception `yaml:",inline"`
 }
 
 // OutputConfig represents output configurations.
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Options restrict the generated configuration schema.
+type Options struct {
+	// ProviderID restricts the schema to a single provider.
+	ProviderID string
+	// RulesetID restricts the schema to a single ruleset of the provider.
+	RulesetID string
+	// RulesetVersion restricts the schema to a single version of the ruleset.
+	RulesetVersion string
+}
+
+// ForConfig returns the schema of a Diki configuration file for the given providers.
+// The known providers, rulesets and versions are taken from the [provider.MetadataFunc]s.
+// The arguments of providers, rulesets and rules are described if the provider option
+// has a [provider.ArgsTypesFunc].
+func ForConfig(providerOptions map[string]provider.ProviderOption, opts Options) (*Schema, error) {
+	switch {
+	case len(opts.RulesetID) > 0 && len(opts.ProviderID) == 0:
+		return nil, errors.New("ruleset can only be set together with provider")
+	case len(opts.RulesetVersion) > 0 && len(opts.RulesetID) == 0:
+		return nil, errors.New("version can only be set together with ruleset")
+	}
+
+	providerIDs := slices.Sorted(maps.Keys(providerOptions))
+	if len(opts.ProviderID) > 0 {
+		if _, ok := providerOptions[opts.ProviderID]; !ok {
+			return nil, fmt.Errorf("unknown provider: %s", opts.ProviderID)
+		}
+		providerIDs = []string{opts.ProviderID}
+	}
+
+	s := For(config.DikiConfig{})
+	s.Schema = Draft
+	s.Title = "Diki configuration"
+	s.Properties["numWorkers"].Minimum = ptr.To(0)
+	minStatusSchema := s.Properties["output"].Properties["minStatus"]
+	for _, status := range rule.Statuses() {
+		minStatusSchema.Enum = append(minStatusSchema.Enum, string(status))
+	}
+
+	providerSchema := s.Properties["providers"].Items
+	providerSchema.Properties["numWorkers"].Minimum = ptr.To(0)
+	providerSchema.Required = []string{"id"}
+	providerSchema.Properties["id"].Enum = providerIDs
+	for _, providerID := range providerIDs {
+		then, err := forProvider(providerOptions[providerID], opts)
+		if err != nil {
+			return nil, err
+		}
+		providerSchema.AllOf = append(providerSchema.AllOf, ifEqual(map[string]string{"id": providerID}, then))
+	}
+	return s, nil
+}
+
+// forProvider returns the schema that applies to the configuration of a provider.
+func forProvider(providerOption provider.ProviderOption, opts Options) (*Schema, error) {
+	var (
+		argsTypes      provider.ArgsTypes
+		rulesetSchema  = For(config.RulesetConfig{})
+		providerSchema = &Schema{
+			Properties: map[string]*Schema{
+				"rulesets": {Items: rulesetSchema},
+			},
+		}
+	)
+	rulesetSchema.Required = []string{"id", "version"}
+	rulesetSchema.Properties["numWorkers"].Minimum = ptr.To(0)
+	rulesetSchema.Properties["ruleOptions"].Items.Required = []string{"ruleID"}
+
+	if providerOption.ArgsTypesFunc != nil {
+		argsTypes = providerOption.ArgsTypesFunc()
+		providerSchema.Properties["args"] = For(argsTypes.Args)
+	}
+
+	if providerOption.MetadataFunc == nil {
+		return providerSchema, nil
+	}
+
+	var (
+		providerMetadata = providerOption.MetadataFunc()
+		rulesetIDs       []string
+	)
+	for _, rulesetMetadata := range providerMetadata.Rulesets {
+		if len(opts.RulesetID) > 0 && rulesetMetadata.ID != opts.RulesetID {
+			continue
+		}
+		rulesetIDs = append(rulesetIDs, rulesetMetadata.ID)
+
+		var versions []string
+		for _, version := range rulesetMetadata.Versions {
+			if len(opts.RulesetVersion) > 0 && version.Version != opts.RulesetVersion {
+				continue
+			}
+			versions = append(versions, version.Version)
+		}
+		if len(versions) == 0 {
+			return nil, fmt.Errorf("unknown version of ruleset %s: %s", rulesetMetadata.ID, opts.RulesetVersion)
+		}
+
+		rulesetSchema.AllOf = append(rulesetSchema.AllOf, ifEqual(
+			map[string]string{"id": rulesetMetadata.ID},
+			&Schema{Properties: map[string]*Schema{"version": {Enum: versions}}},
+		))
+
+		if providerOption.ArgsTypesFunc == nil {
+			continue
+		}
+		for _, version := range versions {
+			rulesetArgsTypes, ok := argsTypes.Rulesets[rulesetMetadata.ID][version]
+			if !ok {
+				continue
+			}
+			rulesetSchema.AllOf = append(rulesetSchema.AllOf, ifEqual(
+				map[string]string{"id": rulesetMetadata.ID, "version": version},
+				forRulesetArgs(rulesetArgsTypes),
+			))
+		}
+	}
+
+	if len(rulesetIDs) == 0 {
+		return nil, fmt.Errorf("unknown ruleset for provider %s: %s", providerMetadata.ID, opts.RulesetID)
+	}
+	rulesetSchema.Properties["id"].Enum = rulesetIDs
+	return providerSchema, nil
+}
+
+// forRulesetArgs returns the schema that applies to the configuration of a ruleset version
+// with the given argument types. Arguments are not allowed for rules that do not accept any.
+func forRulesetArgs(argsTypes provider.RulesetArgsTypes) *Schema {
+	rulesetArgs := False()
+	if argsTypes.Args != nil {
+		rulesetArgs = For(argsTypes.Args)
+	}
+
+	if argsTypes.AnyRuleArgs {
+		return &Schema{
+			Properties: map[string]*Schema{
+				"args": rulesetArgs,
+				"ruleOptions": {Items: &Schema{
+					Properties: map[string]*Schema{"args": {Type: "object", nullable: true}},
+				}},
+			},
+		}
+	}
+
+	var (
+		ruleIDs    = slices.Sorted(maps.Keys(argsTypes.RuleArgs))
+		ruleSchema = &Schema{}
+	)
+	for _, ruleID := range ruleIDs {
+		ruleSchema.AllOf = append(ruleSchema.AllOf, ifEqual(
+			map[string]string{"ruleID": ruleID},
+			&Schema{Properties: map[string]*Schema{"args": For(argsTypes.RuleArgs[ruleID])}},
+		))
+	}
+
+	noArgs := &Schema{Properties: map[string]*Schema{"args": False()}}
+	if len(ruleIDs) == 0 {
+		ruleSchema.AllOf = append(ruleSchema.AllOf, noArgs)
+	} else {
+		ruleSchema.AllOf = append(ruleSchema.AllOf, &Schema{
+			If:   &Schema{Properties: map[string]*Schema{"ruleID": {Enum: ruleIDs}}},
+			Else: noArgs,
+		})
+	}
+
+	return &Schema{
+		Properties: map[string]*Schema{
+			"args":        rulesetArgs,
+			"ruleOptions": {Items: ruleSchema},
+		},
+	}
+}
+
+// ifEqual returns a schema that applies then to objects whose properties have the given values.
+func ifEqual(values map[string]string, then *Schema) *Schema {
+	condition := &Schema{
+		Properties: map[string]*Schema{},
+		Required:   slices.Sorted(maps.Keys(values)),
+	}
+	for property, value := range values {
+		condition.Properties[property] = &Schema{Const: value}
+	}
+	return &Schema{If: condition, Then: then}
+}
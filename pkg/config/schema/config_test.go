@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/gardener/diki/pkg/config/schema"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/builder"
+)
+
+var _ = Describe("Config", func() {
+	var providerOptions map[string]provider.ProviderOption
+
+	BeforeEach(func() {
+		providerOptions = map[string]provider.ProviderOption{
+			"garden":        {MetadataFunc: builder.GardenProviderMetadata, ArgsTypesFunc: builder.GardenArgsTypes},
+			"gardener":      {MetadataFunc: builder.GardenerProviderMetadata, ArgsTypesFunc: builder.GardenerArgsTypes},
+			"managedk8s":    {MetadataFunc: builder.ManagedK8SProviderMetadata, ArgsTypesFunc: builder.ManagedK8SArgsTypes},
+			"snapshot":      {MetadataFunc: builder.SnapshotProviderMetadata, ArgsTypesFunc: builder.SnapshotArgsTypes},
+			"virtualgarden": {MetadataFunc: builder.VirtualGardenProviderMetadata, ArgsTypesFunc: builder.VirtualGardenArgsTypes},
+		}
+	})
+
+	validate := func(opts schema.Options, configData []byte) []string {
+		configSchema, err := schema.ForConfig(providerOptions, opts)
+		Expect(err).NotTo(HaveOccurred())
+		schemaData, err := json.Marshal(configSchema)
+		Expect(err).NotTo(HaveOccurred())
+
+		var dikiConfig any
+		Expect(yaml.Unmarshal(configData, &dikiConfig)).To(Succeed())
+
+		result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schemaData), gojsonschema.NewGoLoader(dikiConfig))
+		Expect(err).NotTo(HaveOccurred())
+
+		var errs []string
+		for _, resultErr := range result.Errors() {
+			errs = append(errs, resultErr.String())
+		}
+		return errs
+	}
+
+	Describe("#ForConfig", func() {
+		It("should accept the example configurations", func() {
+			files, err := filepath.Glob("../../../example/config/*.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).NotTo(BeEmpty())
+
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(validate(schema.Options{}, data)).To(BeEmpty(), file)
+			}
+		})
+
+		It("should accept valid rule arguments", func() {
+			data := []byte(`
+providers:
+- id: managedk8s
+  args:
+    kubeconfigPath: /tmp/kubeconfig
+  rulesets:
+  - id: disa-kubernetes-stig
+    version: v2r3
+    args:
+      maxRetries: 2
+    ruleOptions:
+    - ruleID: "242376"
+      skip:
+        enabled: true
+    - ruleID: "242414"
+      args:
+        acceptedPods:
+        - podMatchLabels:
+            foo: bar
+          namespaceMatchLabels:
+            foo: bar
+          ports: [53]
+`)
+			Expect(validate(schema.Options{}, data)).To(BeEmpty())
+		})
+
+		It("should accept any object as arguments of the rules of the plugin ruleset", func() {
+			data := []byte(`
+providers:
+- id: managedk8s
+  args:
+    kubeconfigPath: /tmp/kubeconfig
+  rulesets:
+  - id: plugin
+    version: v0.1.0
+    args:
+      plugins:
+      - id: "1001"
+        name: Foo
+        command: /usr/local/bin/foo
+    ruleOptions:
+    - ruleID: "1001"
+      args:
+        foo: bar
+`)
+			Expect(validate(schema.Options{}, data)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid configurations",
+			func(opts schema.Options, data string, expectedErr string) {
+				Expect(validate(opts, []byte(data))).To(ContainElement(ContainSubstring(expectedErr)))
+			},
+			Entry("unknown provider", schema.Options{}, `
+providers:
+- id: foo
+`, "providers.0.id: providers.0.id must be one of the following"),
+			Entry("provider not in the restricted schema", schema.Options{ProviderID: "garden"}, `
+providers:
+- id: managedk8s
+`, "providers.0.id: providers.0.id must be one of the following"),
+			Entry("unknown ruleset version", schema.Options{}, `
+providers:
+- id: gardener
+  rulesets:
+  - id: disa-kubernetes-stig
+    version: v1r1
+`, "providers.0.rulesets.0.version: providers.0.rulesets.0.version must be one of the following"),
+			Entry("unknown provider argument", schema.Options{}, `
+providers:
+- id: garden
+  args:
+    kubeconfig: /tmp/kubeconfig
+`, "Additional property kubeconfig is not allowed"),
+			Entry("invalid rule arguments", schema.Options{}, `
+providers:
+- id: gardener
+  rulesets:
+  - id: disa-kubernetes-stig
+    version: v2r2
+    ruleOptions:
+    - ruleID: "242414"
+      args:
+        acceptedPods: foo
+`, "providers.0.rulesets.0.ruleOptions.0.args.acceptedPods: Invalid type. Expected: [array,null], given: string"),
+			Entry("arguments of a rule that does not accept any", schema.Options{}, `
+providers:
+- id: managedk8s
+  rulesets:
+  - id: disa-kubernetes-stig
+    version: v2r3
+    ruleOptions:
+    - ruleID: "242376"
+      args:
+        foo: bar
+`, "providers.0.rulesets.0.ruleOptions.0.args: False always fails validation"),
+			Entry("arguments of a ruleset that does not accept any", schema.Options{}, `
+providers:
+- id: managedk8s
+  rulesets:
+  - id: security-hardened-k8s
+    version: v0.1.0
+    args:
+      foo: bar
+`, "providers.0.rulesets.0.args: False always fails validation"),
+			Entry("negative number of workers", schema.Options{}, `
+numWorkers: -1
+providers: []
+`, "numWorkers: Must be greater than or equal to 0"),
+		)
+
+		It("should only describe the given ruleset version", func() {
+			data := []byte(`
+providers:
+- id: virtualgarden
+  rulesets:
+  - id: disa-kubernetes-stig
+    version: v2r2
+`)
+			Expect(validate(schema.Options{ProviderID: "virtualgarden", RulesetID: "disa-kubernetes-stig", RulesetVersion: "v2r3"}, data)).To(ContainElement(ContainSubstring("must be one of the following: \"v2r3\"")))
+		})
+
+		DescribeTable("should return an error for invalid options",
+			func(opts schema.Options, expectedErr string) {
+				_, err := schema.ForConfig(providerOptions, opts)
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("ruleset without provider", schema.Options{RulesetID: "foo"}, "ruleset can only be set together with provider"),
+			Entry("version without ruleset", schema.Options{ProviderID: "garden", RulesetVersion: "v1"}, "version can only be set together with ruleset"),
+			Entry("unknown provider", schema.Options{ProviderID: "foo"}, "unknown provider: foo"),
+			Entry("unknown ruleset", schema.Options{ProviderID: "garden", RulesetID: "foo"}, "unknown ruleset for provider garden: foo"),
+			Entry("unknown version", schema.Options{ProviderID: "garden", RulesetID: "security-hardened-shoot-cluster", RulesetVersion: "foo"}, "unknown version of ruleset security-hardened-shoot-cluster: foo"),
+		)
+	})
+})
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"k8s.io/utils/ptr"
+)
+
+// Draft is the JSON Schema draft of the generated schemas.
+const Draft = "http://json-schema.org/draft-07/schema#"
+
+// Schema is a JSON Schema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Const                string             `json:"const,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	If                   *Schema            `json:"if,omitempty"`
+	Then                 *Schema            `json:"then,omitempty"`
+	Else                 *Schema            `json:"else,omitempty"`
+
+	// never marks the schema that no value is valid against.
+	never bool
+	// nullable marks the schema whose values can also be null.
+	nullable bool
+}
+
+// False returns a schema that no value is valid against.
+func False() *Schema {
+	return &Schema{never: true}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.never {
+		return []byte("false"), nil
+	}
+	type plain Schema
+	if !s.nullable || len(s.Type) == 0 {
+		return json.Marshal((*plain)(s))
+	}
+	return json.Marshal(struct {
+		*plain
+		Type []string `json:"type"`
+	}{(*plain)(s), []string{s.Type, "null"}})
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// For returns the schema of the type of v. Struct fields are named after their
+// json tags or, if they do not have one, after their yaml tags. Fields of embedded
+// structs without a tag are inlined. Other fields without a tag are named in lower
+// camel case, as json matches field names case-insensitively. Additional properties
+// are not allowed in objects that represent structs. Pointers, slices and maps can also be null.
+func For(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return forType(reflect.TypeOf(v), map[reflect.Type]bool{})
+}
+
+func forType(t reflect.Type, visiting map[reflect.Type]bool) *Schema {
+	if t == durationType {
+		return &Schema{Type: "string", Description: `A duration, e.g. "1h30m".`}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := forType(t.Elem(), visiting)
+		s.nullable = true
+		return s
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: ptr.To(0)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string"}
+		}
+		return &Schema{Type: "array", Items: forType(t.Elem(), visiting), nullable: true}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: forType(t.Elem(), visiting), nullable: true}
+	case reflect.Struct:
+		// recursive types are not described beyond their first occurrence
+		if visiting[t] {
+			return &Schema{}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		s := &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: False()}
+		addFields(s, t, visiting)
+		return s
+	default:
+		return &Schema{}
+	}
+}
+
+func addFields(s *Schema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, ok := fieldName(f)
+		if !ok {
+			continue
+		}
+
+		fieldType := f.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if f.Anonymous && len(name) == 0 && fieldType.Kind() == reflect.Struct {
+			addFields(s, fieldType, visiting)
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if len(name) == 0 {
+			name = lowerCamelCase(f.Name)
+		}
+		s.Properties[name] = forType(f.Type, visiting)
+	}
+}
+
+// fieldName returns the name of a struct field from its json or yaml tag.
+// It returns false if the field is ignored.
+func fieldName(f reflect.StructField) (string, bool) {
+	for _, key := range []string{"json", "yaml"} {
+		tag, ok := f.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false
+		}
+		return name, true
+	}
+	return "", true
+}
+
+// lowerCamelCase lower cases the leading upper case letters of a name,
+// but the last one if it starts the next word, e.g. "URLPath" becomes "urlPath".
+func lowerCamelCase(name string) string {
+	runes := []rune(name)
+	i := 0
+	for i < len(runes) && unicode.IsUpper(runes[i]) {
+		i++
+	}
+	if i > 1 && i < len(runes) {
+		i--
+	}
+	for j := range i {
+		runes[j] = unicode.ToLower(runes[j])
+	}
+	return string(runes)
+}
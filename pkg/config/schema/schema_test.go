@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config/schema"
+)
+
+type embedded struct {
+	Labels map[string]string `json:"labels"`
+}
+
+type node struct {
+	embedded
+	Name     string        `json:"name" yaml:"yamlName"`
+	Port     *uint16       `yaml:"port"`
+	Ratio    float64       `json:"ratio,omitempty"`
+	Enabled  bool          `json:"enabled"`
+	Timeout  time.Duration `json:"timeout"`
+	Children []node        `json:"children"`
+	Ignored  string        `json:"-"`
+	Any      any           `json:"any"`
+	Untagged int32
+	URLPath  string
+	private  string
+}
+
+var _ = Describe("Schema", func() {
+	Describe("#For", func() {
+		It("should describe the type of the value", func() {
+			data, err := json.Marshal(schema.For(node{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{
+  "type": "object",
+  "properties": {
+    "labels": {"type": ["object", "null"], "additionalProperties": {"type": "string"}},
+    "name": {"type": "string"},
+    "port": {"type": ["integer", "null"], "minimum": 0},
+    "ratio": {"type": "number"},
+    "enabled": {"type": "boolean"},
+    "timeout": {"type": "string", "description": "A duration, e.g. \"1h30m\"."},
+    "children": {"type": ["array", "null"], "items": {}},
+    "any": {},
+    "untagged": {"type": "integer"},
+    "urlPath": {"type": "string"}
+  },
+  "additionalProperties": false
+}`))
+		})
+
+		It("should return an empty schema for nil", func() {
+			data, err := json.Marshal(schema.For(nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{}`))
+		})
+	})
+
+	Describe("#False", func() {
+		It("should be marshaled as false", func() {
+			data, err := json.Marshal(&schema.Schema{Properties: map[string]*schema.Schema{"foo": schema.False()}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"properties": {"foo": false}}`))
+		})
+	})
+})
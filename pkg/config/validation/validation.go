@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Error is a problem found in a Diki configuration file.
+type Error struct {
+	// Line is the line in the configuration file where the problem was found.
+	// It is 0 if the line is unknown.
+	Line int `json:"line"`
+	// Path is the path of the field in the configuration.
+	Path string `json:"path,omitempty"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Message)
+}
+
+var (
+	yamlLineRegexp = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	pathElemRegexp = regexp.MustCompile(`([^.\[\]]+)|\[(\d+)\]`)
+)
+
+// ValidateConfig parses a Diki configuration and validates it without connecting to any cluster.
+// It reports unknown or duplicate providers, rulesets and versions, rule options for rules
+// that do not exist in the ruleset version, duplicate rule options and invalid ruleset and rule arguments.
+// Rulesets are only validated in depth if their provider option has a [provider.RulesFromConfigFunc].
+// The returned errors are sorted by their line.
+func ValidateConfig(data []byte, providerOptions map[string]provider.ProviderOption) []Error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return []Error{errorFromYAMLMessage(err.Error())}
+	}
+
+	if len(root.Content) == 0 {
+		return []Error{{Message: "configuration is empty"}}
+	}
+
+	var (
+		dikiConfig config.DikiConfig
+		errs       []Error
+		decoder    = yaml.NewDecoder(bytes.NewReader(data))
+	)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&dikiConfig); err != nil && !errors.Is(err, io.EOF) {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return []Error{errorFromYAMLMessage(err.Error())}
+		}
+		// the configuration is still decoded as far as possible
+		for _, msg := range typeErr.Errors {
+			errs = append(errs, errorFromYAMLMessage(msg))
+		}
+	}
+
+	v := &validator{providerOptions: providerOptions}
+	errs = append(errs, v.validateDikiConfig(dikiConfig, root.Content[0])...)
+
+	slices.SortStableFunc(errs, func(a, b Error) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	return errs
+}
+
+func errorFromYAMLMessage(msg string) Error {
+	matches := yamlLineRegexp.FindStringSubmatch(msg)
+	if matches == nil {
+		return Error{Message: msg}
+	}
+
+	line, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return Error{Message: msg}
+	}
+	return Error{Line: line, Message: matches[2]}
+}
+
+type validator struct {
+	providerOptions map[string]provider.ProviderOption
+}
+
+func (v *validator) validateDikiConfig(dikiConfig config.DikiConfig, node *yaml.Node) []Error {
+	var errs []Error
+
+	if dikiConfig.NumWorkers < 0 {
+		errs = append(errs, newError(node, field.NewPath("numWorkers"), "should not be a negative number"))
+	}
+
+	if dikiConfig.Timeout < 0 {
+		errs = append(errs, newError(node, field.NewPath("timeout"), "should not be a negative duration"))
+	}
+
+	if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 && !slices.Contains(rule.Statuses(), rule.Status(dikiConfig.Output.MinStatus)) {
+		errs = append(errs, newError(node, field.NewPath("output", "minStatus"), fmt.Sprintf("not defined status: %s", dikiConfig.Output.MinStatus)))
+	}
+
+	var (
+		providersPath = field.NewPath("providers")
+		providerIDs   = map[string]bool{}
+	)
+	for i, providerConfig := range dikiConfig.Providers {
+		providerPath := providersPath.Index(i)
+		if providerIDs[providerConfig.ID] {
+			errs = append(errs, newError(node, providerPath.Child("id"), fmt.Sprintf("duplicate provider: %s", providerConfig.ID)))
+			continue
+		}
+		providerIDs[providerConfig.ID] = true
+
+		errs = append(errs, v.validateProviderConfig(providerConfig, node, providerPath)...)
+	}
+	return errs
+}
+
+func (v *validator) validateProviderConfig(providerConfig config.ProviderConfig, node *yaml.Node, fldPath *field.Path) []Error {
+	var errs []Error
+
+	providerOption, ok := v.providerOptions[providerConfig.ID]
+	if !ok {
+		return append(errs, newError(node, fldPath.Child("id"), fmt.Sprintf("unknown provider: %s", providerConfig.ID)))
+	}
+
+	if providerConfig.NumWorkers < 0 {
+		errs = append(errs, newError(node, fldPath.Child("numWorkers"), "should not be a negative number"))
+	}
+
+	supportedVersions := map[string][]string{}
+	if providerOption.MetadataFunc != nil {
+		for _, rulesetMetadata := range providerOption.MetadataFunc().Rulesets {
+			for _, version := range rulesetMetadata.Versions {
+				supportedVersions[rulesetMetadata.ID] = append(supportedVersions[rulesetMetadata.ID], version.Version)
+			}
+		}
+	}
+
+	type rulesetKey struct {
+		id, version string
+	}
+
+	var (
+		rulesetsPath = fldPath.Child("rulesets")
+		rulesets     = map[rulesetKey]bool{}
+	)
+	for i, rulesetConfig := range providerConfig.Rulesets {
+		rulesetPath := rulesetsPath.Index(i)
+		if providerOption.MetadataFunc != nil {
+			versions, ok := supportedVersions[rulesetConfig.ID]
+			if !ok {
+				errs = append(errs, newError(node, rulesetPath.Child("id"), fmt.Sprintf("unknown ruleset for provider %s: %s", providerConfig.ID, rulesetConfig.ID)))
+				continue
+			}
+
+			if !slices.Contains(versions, rulesetConfig.Version) {
+				errs = append(errs, newError(node, rulesetPath.Child("version"), fmt.Sprintf("unknown version of ruleset %s: %s, supported versions are %v", rulesetConfig.ID, rulesetConfig.Version, versions)))
+				continue
+			}
+		}
+
+		key := rulesetKey{rulesetConfig.ID, rulesetConfig.Version}
+		if rulesets[key] {
+			errs = append(errs, newError(node, rulesetPath.Child("id"), fmt.Sprintf("duplicate ruleset %s with version %s", rulesetConfig.ID, rulesetConfig.Version)))
+			continue
+		}
+		rulesets[key] = true
+
+		errs = append(errs, validateRulesetConfig(rulesetConfig, providerOption.RulesFromConfigFunc, node, rulesetPath)...)
+	}
+	return errs
+}
+
+func validateRulesetConfig(rulesetConfig config.RulesetConfig, rulesFunc provider.RulesFromConfigFunc, node *yaml.Node, fldPath *field.Path) []Error {
+	var errs []Error
+
+	if rulesetConfig.NumWorkers < 0 {
+		errs = append(errs, newError(node, fldPath.Child("numWorkers"), "should not be a negative number"))
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		errs = append(errs, newError(node, fldPath.Child("timeout"), "should not be a negative duration"))
+	}
+
+	var (
+		ruleOptionsPath = fldPath.Child("ruleOptions")
+		ruleIDs         = map[string]bool{}
+	)
+
+	// options of each rule are validated separately so that all problems are reported
+	baseConfig := rulesetConfig
+	baseConfig.NumWorkers, baseConfig.Timeout, baseConfig.RuleOptions = 0, 0, nil
+
+	var rules []rule.Rule
+	if rulesFunc != nil {
+		var err error
+		if rules, err = rulesFunc(baseConfig); err != nil {
+			return append(errs, newError(node, fldPath.Child("args"), err.Error()))
+		}
+	}
+
+	for i, ruleOption := range rulesetConfig.RuleOptions {
+		ruleOptionPath := ruleOptionsPath.Index(i)
+		switch {
+		case len(ruleOption.RuleID) == 0:
+			errs = append(errs, newError(node, ruleOptionPath.Child("ruleID"), "should not be empty"))
+			continue
+		case ruleIDs[ruleOption.RuleID]:
+			errs = append(errs, newError(node, ruleOptionPath.Child("ruleID"), fmt.Sprintf("duplicate rule option for rule %s", ruleOption.RuleID)))
+			continue
+		}
+		ruleIDs[ruleOption.RuleID] = true
+
+		if ruleOption.Timeout < 0 {
+			errs = append(errs, newError(node, ruleOptionPath.Child("timeout"), "should not be a negative duration"))
+		}
+
+		if ruleOption.Skip != nil {
+			if _, err := ruleOption.Skip.Expiration(); err != nil {
+				errs = append(errs, newError(node, ruleOptionPath.Child("skip", "expiresAt"), err.Error()))
+			}
+		}
+
+		if rulesFunc == nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(rules, func(r rule.Rule) bool { return r.ID() == ruleOption.RuleID }) {
+			errs = append(errs, newError(node, ruleOptionPath.Child("ruleID"), fmt.Sprintf("rule %s does not exist in ruleset %s version %s", ruleOption.RuleID, rulesetConfig.ID, rulesetConfig.Version)))
+			continue
+		}
+
+		ruleOptionConfig := baseConfig
+		ruleOption.Timeout = 0
+		ruleOptionConfig.RuleOptions = []config.RuleOptionsConfig{ruleOption}
+		if _, err := rulesFunc(ruleOptionConfig); err != nil {
+			errs = append(errs, newError(node, ruleOptionPath.Child("args"), err.Error()))
+		}
+	}
+	return errs
+}
+
+// newError creates an Error for the field with the given path.
+func newError(root *yaml.Node, fldPath *field.Path, msg string) Error {
+	return Error{
+		Line:    lookupLine(root, fldPath),
+		Path:    fldPath.String(),
+		Message: msg,
+	}
+}
+
+// lookupLine returns the line of the field with the given path. If the field
+// is not present in the configuration the line of its deepest present ancestor is returned.
+func lookupLine(root *yaml.Node, fldPath *field.Path) int {
+	var (
+		node = root
+		line = root.Line
+	)
+
+	for _, elem := range pathElemRegexp.FindAllStringSubmatch(fldPath.String(), -1) {
+		key, index := elem[1], elem[2]
+		switch {
+		case len(key) > 0 && node.Kind == yaml.MappingNode:
+			i := mappingKeyIndex(node, key)
+			if i < 0 {
+				return line
+			}
+			node, line = node.Content[i+1], node.Content[i].Line
+		case len(index) > 0 && node.Kind == yaml.SequenceNode:
+			i, err := strconv.Atoi(index)
+			if err != nil || i >= len(node.Content) {
+				return line
+			}
+			node, line = node.Content[i], node.Content[i].Line
+		default:
+			return line
+		}
+	}
+	return line
+}
+
+// mappingKeyIndex returns the index of the key with the given value in the content of a mapping node or -1 if it is not present.
+func mappingKeyIndex(node *yaml.Node, key string) int {
+	// keys and values alternate in the content of mapping nodes
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/config/validation"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("validation", func() {
+	var providerOptions map[string]provider.ProviderOption
+
+	BeforeEach(func() {
+		providerOptions = map[string]provider.ProviderOption{
+			"foo": {
+				MetadataFunc: func() metadata.ProviderDetailed {
+					return metadata.ProviderDetailed{
+						Provider: metadata.Provider{ID: "foo", Name: "Foo"},
+						Rulesets: []metadata.Ruleset{
+							{ID: "bar", Name: "Bar", Versions: []metadata.Version{{Version: "v2", Latest: true}, {Version: "v1"}}},
+						},
+					}
+				},
+				RulesFromConfigFunc: func(conf config.RulesetConfig) ([]rule.Rule, error) {
+					if conf.Args != nil {
+						return nil, errors.New("ruleset does not accept args")
+					}
+					for _, opt := range conf.RuleOptions {
+						if opt.Args != nil {
+							return nil, fmt.Errorf("rule option %s error: invalid args", opt.RuleID)
+						}
+					}
+					return []rule.Rule{
+						rule.NewSkipRule("1", "one", "", rule.Passed),
+						rule.NewSkipRule("2", "two", "", rule.Passed),
+					}, nil
+				},
+			},
+		}
+	})
+
+	It("should not return errors for a valid configuration", func() {
+		data := []byte(`providers:
+- id: foo
+  rulesets:
+  - id: bar
+    version: v1
+    ruleOptions:
+    - ruleID: "1"
+      timeout: 10m
+    - ruleID: "2"
+      skip:
+        enabled: true
+        justification: foo
+        expiresAt: 2026-12-31
+        owner: team-foo
+        reference: https://example.com/issues/1
+  - id: bar
+    version: v2
+numWorkers: 2
+output:
+  minStatus: Failed
+`)
+		Expect(validation.ValidateConfig(data, providerOptions)).To(BeEmpty())
+	})
+
+	It("should return all errors with their lines", func() {
+		data := []byte(`providers:
+- id: foo
+  numWorkers: -1
+  rulesets:
+  - id: bar
+    version: v1
+    ruleOptions:
+    - ruleID: "3"
+    - ruleID: "1"
+      args:
+        foo: bar
+    - ruleID: "1"
+    - ruleID: "2"
+      timeout: -1s
+      skip:
+        enabled: true
+        expiresAt: tomorrow
+  - id: bar
+    version: v1
+  - id: baz
+    version: v1
+  - id: bar
+    version: v3
+- id: unknown
+output:
+  minStatus: Foo
+  foo: bar
+`)
+		Expect(validation.ValidateConfig(data, providerOptions)).To(Equal([]validation.Error{
+			{Line: 3, Path: "providers[0].numWorkers", Message: "should not be a negative number"},
+			{Line: 8, Path: "providers[0].rulesets[0].ruleOptions[0].ruleID", Message: "rule 3 does not exist in ruleset bar version v1"},
+			{Line: 10, Path: "providers[0].rulesets[0].ruleOptions[1].args", Message: "rule option 1 error: invalid args"},
+			{Line: 12, Path: "providers[0].rulesets[0].ruleOptions[2].ruleID", Message: "duplicate rule option for rule 1"},
+			{Line: 14, Path: "providers[0].rulesets[0].ruleOptions[3].timeout", Message: "should not be a negative duration"},
+			{Line: 17, Path: "providers[0].rulesets[0].ruleOptions[3].skip.expiresAt", Message: "invalid expiration date tomorrow, expected format YYYY-MM-DD"},
+			{Line: 18, Path: "providers[0].rulesets[1].id", Message: "duplicate ruleset bar with version v1"},
+			{Line: 20, Path: "providers[0].rulesets[2].id", Message: "unknown ruleset for provider foo: baz"},
+			{Line: 23, Path: "providers[0].rulesets[3].version", Message: "unknown version of ruleset bar: v3, supported versions are [v2 v1]"},
+			{Line: 24, Path: "providers[1].id", Message: "unknown provider: unknown"},
+			{Line: 26, Path: "output.minStatus", Message: "not defined status: Foo"},
+			{Line: 27, Message: "field foo not found in type config.OutputConfig"},
+		}))
+	})
+
+	It("should return the line of a key whose name is also used as a value", func() {
+		data := []byte(`providers:
+- id: foo
+  rulesets:
+  - id: bar
+    version: v1
+    ruleOptions:
+    - ruleID: timeout
+      timeout: -1s
+`)
+		Expect(validation.ValidateConfig(data, providerOptions)).To(Equal([]validation.Error{
+			{Line: 7, Path: "providers[0].rulesets[0].ruleOptions[0].ruleID", Message: "rule timeout does not exist in ruleset bar version v1"},
+			{Line: 8, Path: "providers[0].rulesets[0].ruleOptions[0].timeout", Message: "should not be a negative duration"},
+		}))
+	})
+
+	It("should return ruleset errors at the ruleset args", func() {
+		data := []byte(`providers:
+- id: foo
+  rulesets:
+  - id: bar
+    version: v1
+    args:
+      foo: bar
+    ruleOptions:
+    - ruleID: "1"
+`)
+		Expect(validation.ValidateConfig(data, providerOptions)).To(Equal([]validation.Error{
+			{Line: 6, Path: "providers[0].rulesets[0].args", Message: "ruleset does not accept args"},
+		}))
+	})
+
+	It("should return an error for invalid yaml", func() {
+		errs := validation.ValidateConfig([]byte("providers:\n- id: foo\n  rulesets: [\n"), providerOptions)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Line).To(Equal(3))
+	})
+})
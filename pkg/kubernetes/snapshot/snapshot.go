@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+)
+
+const (
+	// MetadataFile is the name of the file in a snapshot that contains the [Metadata] of the snapshot.
+	MetadataFile = "metadata.json"
+	// ObjectsDir is the directory in a snapshot that contains a file with the objects of each kind.
+	ObjectsDir = "objects"
+)
+
+// ListKinds are the list kinds of the objects that are written to a snapshot. Besides the objects
+// read by the rules, it contains the kinds of the 'all' category that are read by some rules.
+var ListKinds = []schema.GroupVersionKind{
+	corev1.SchemeGroupVersion.WithKind("NamespaceList"),
+	corev1.SchemeGroupVersion.WithKind("NodeList"),
+	corev1.SchemeGroupVersion.WithKind("PodList"),
+	corev1.SchemeGroupVersion.WithKind("ServiceList"),
+	corev1.SchemeGroupVersion.WithKind("ReplicationControllerList"),
+	appsv1.SchemeGroupVersion.WithKind("DeploymentList"),
+	appsv1.SchemeGroupVersion.WithKind("DaemonSetList"),
+	appsv1.SchemeGroupVersion.WithKind("ReplicaSetList"),
+	appsv1.SchemeGroupVersion.WithKind("StatefulSetList"),
+	autoscalingv1.SchemeGroupVersion.WithKind("HorizontalPodAutoscalerList"),
+	batchv1.SchemeGroupVersion.WithKind("JobList"),
+	batchv1.SchemeGroupVersion.WithKind("CronJobList"),
+	networkingv1.SchemeGroupVersion.WithKind("NetworkPolicyList"),
+	rbacv1.SchemeGroupVersion.WithKind("RoleList"),
+	rbacv1.SchemeGroupVersion.WithKind("ClusterRoleList"),
+	storagev1.SchemeGroupVersion.WithKind("StorageClassList"),
+}
+
+// Metadata describes a snapshot.
+type Metadata struct {
+	// Time is the time at which the snapshot was taken.
+	Time time.Time `json:"time"`
+	// Host is the host of the kube-apiserver of the cluster.
+	Host string `json:"host,omitempty"`
+	// Kinds are the list kinds of the objects in the snapshot, e.g. "v1/PodList".
+	Kinds []string `json:"kinds"`
+}
+
+// Write lists the objects of all [ListKinds] with the given client and
+// writes them together with the metadata as a gzip compressed tarball.
+// The managed fields of the objects are not written.
+func Write(ctx context.Context, c client.Client, metadata Metadata, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata.Kinds = nil
+	for _, listKind := range ListKinds {
+		objects, err := kubeutils.GetObjects(ctx, c, listKind, "", labels.NewSelector(), 300)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", listKind.Kind, err)
+		}
+
+		objectList := &unstructured.UnstructuredList{Items: objects}
+		objectList.SetGroupVersionKind(listKind)
+		for i := range objectList.Items {
+			objectList.Items[i].SetManagedFields(nil)
+		}
+
+		data, err := objectList.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		if err := writeFile(tarWriter, fileName(listKind), data, metadata.Time); err != nil {
+			return err
+		}
+		metadata.Kinds = append(metadata.Kinds, listKind.GroupVersion().String()+"/"+listKind.Kind)
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(tarWriter, MetadataFile, data, metadata.Time); err != nil {
+		return err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// Load reads a snapshot written by [Write] and returns a client that serves the objects of the snapshot.
+// Objects that are not part of the snapshot are served as empty lists.
+func Load(r io.Reader) (client.Client, Metadata, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, Metadata{}, fmt.Errorf("snapshot is not gzip compressed: %w", err)
+	}
+	defer gzipReader.Close()
+
+	var (
+		tarReader   = tar.NewReader(gzipReader)
+		metadata    Metadata
+		hasMetadata bool
+		objects     []runtime.Object
+	)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+
+		switch {
+		case header.Name == MetadataFile:
+			if err := json.Unmarshal(data, &metadata); err != nil {
+				return nil, Metadata{}, fmt.Errorf("invalid snapshot metadata: %w", err)
+			}
+			hasMetadata = true
+		case strings.HasPrefix(header.Name, ObjectsDir+"/") && path.Ext(header.Name) == ".json":
+			objectList := &unstructured.UnstructuredList{}
+			if err := objectList.UnmarshalJSON(data); err != nil {
+				return nil, Metadata{}, fmt.Errorf("invalid snapshot file %s: %w", header.Name, err)
+			}
+			for i := range objectList.Items {
+				objects = append(objects, normalize(&objectList.Items[i]))
+			}
+		}
+	}
+
+	if !hasMetadata {
+		return nil, Metadata{}, fmt.Errorf("snapshot does not contain %s", MetadataFile)
+	}
+
+	return fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objects...).Build(), metadata, nil
+}
+
+// LoadFile reads the snapshot at the given path. See [Load].
+func LoadFile(filePath string) (client.Client, Metadata, error) {
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+// normalize prepares an object of a snapshot to be served by a fake client.
+// The fake client refuses objects that are being deleted, but do not have finalizers,
+// so the deletion timestamp of such objects is removed.
+func normalize(object *unstructured.Unstructured) *unstructured.Unstructured {
+	if object.GetDeletionTimestamp() != nil && len(object.GetFinalizers()) == 0 {
+		object.SetDeletionTimestamp(nil)
+	}
+	return object
+}
+
+// fileName returns the name of the file with the objects of a list kind, e.g. "objects/apps/v1/deployment.json".
+func fileName(listKind schema.GroupVersionKind) string {
+	return path.Join(ObjectsDir, listKind.GroupVersion().String(), strings.ToLower(strings.TrimSuffix(listKind.Kind, "List"))+".json")
+}
+
+func writeFile(tarWriter *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := tarWriter.Write(data)
+	return err
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/kubernetes/snapshot"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+)
+
+var _ = Describe("snapshot", func() {
+	var (
+		ctx      context.Context
+		metadata snapshot.Metadata
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		metadata = snapshot.Metadata{
+			Time: time.Date(2025, time.May, 1, 10, 0, 0, 0, time.UTC),
+			Host: "https://api.foo.bar",
+		}
+	})
+
+	Describe("#Write", func() {
+		It("should write the objects of a cluster that can be loaded", func() {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"foo": "bar"}}}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:          "pod",
+					Namespace:     "foo",
+					Labels:        map[string]string{"app": "bar"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "container", Image: "image"}}},
+			}
+			replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "replica-set", Namespace: "foo"}}
+			clusterRole := &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-role"},
+				Rules:      []rbacv1.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}, APIGroups: []string{""}}},
+			}
+			c := fakeclient.NewClientBuilder().WithObjects(namespace, pod, replicaSet, clusterRole).Build()
+
+			var buffer bytes.Buffer
+			Expect(snapshot.Write(ctx, c, metadata, &buffer)).To(Succeed())
+
+			snapshotClient, snapshotMetadata, err := snapshot.Load(&buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshotMetadata.Time).To(Equal(metadata.Time))
+			Expect(snapshotMetadata.Host).To(Equal(metadata.Host))
+			Expect(snapshotMetadata.Kinds).To(HaveLen(len(snapshot.ListKinds)))
+			Expect(snapshotMetadata.Kinds).To(ContainElements("v1/PodList", "apps/v1/ReplicaSetList", "rbac.authorization.k8s.io/v1/ClusterRoleList"))
+
+			pods, err := kubeutils.GetPods(ctx, snapshotClient, "", labels.NewSelector(), 300)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pods).To(HaveLen(1))
+			Expect(pods[0].Name).To(Equal("pod"))
+			Expect(pods[0].Labels).To(Equal(map[string]string{"app": "bar"}))
+			Expect(pods[0].ManagedFields).To(BeEmpty())
+			Expect(pods[0].Spec.Containers[0].Image).To(Equal("image"))
+
+			namespaces, err := kubeutils.GetNamespaces(ctx, snapshotClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(namespaces).To(HaveKey("foo"))
+			Expect(namespaces["foo"].Labels).To(Equal(map[string]string{"foo": "bar"}))
+
+			clusterRoles, err := kubeutils.GetClusterRoles(ctx, snapshotClient, labels.NewSelector(), 300)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterRoles).To(HaveLen(1))
+			Expect(clusterRoles[0].Rules).To(Equal(clusterRole.Rules))
+
+			objectsMetadata, err := kubeutils.GetAllObjectsMetadata(ctx, snapshotClient, "foo", labels.NewSelector(), 300)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objectsMetadata).To(HaveLen(2))
+
+			services, err := kubeutils.GetServices(ctx, snapshotClient, "", labels.NewSelector(), 300)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(BeEmpty())
+		})
+	})
+
+	Describe("#Load", func() {
+		writeTarball := func(files map[string]string) *bytes.Buffer {
+			var buffer bytes.Buffer
+			gzipWriter := gzip.NewWriter(&buffer)
+			tarWriter := tar.NewWriter(gzipWriter)
+			for name, content := range files {
+				Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))})).To(Succeed())
+				_, err := tarWriter.Write([]byte(content))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tarWriter.Close()).To(Succeed())
+			Expect(gzipWriter.Close()).To(Succeed())
+			return &buffer
+		}
+
+		It("should load objects that are being deleted without finalizers", func() {
+			buffer := writeTarball(map[string]string{
+				snapshot.MetadataFile: `{"time":"2025-05-01T10:00:00Z","kinds":["v1/PodList"]}`,
+				"objects/v1/pod.json": `{"apiVersion":"v1","kind":"PodList","items":[{"metadata":{"name":"pod","namespace":"foo","deletionTimestamp":"2025-05-01T09:59:00Z"}}]}`,
+			})
+
+			snapshotClient, _, err := snapshot.Load(buffer)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod := &corev1.Pod{}
+			Expect(snapshotClient.Get(ctx, client.ObjectKey{Name: "pod", Namespace: "foo"}, pod)).To(Succeed())
+		})
+
+		It("should return an error when the snapshot does not contain metadata", func() {
+			buffer := writeTarball(map[string]string{
+				"objects/v1/pod.json": `{"apiVersion":"v1","kind":"PodList","items":[]}`,
+			})
+
+			_, _, err := snapshot.Load(buffer)
+			Expect(err).To(MatchError("snapshot does not contain metadata.json"))
+		})
+
+		It("should return an error when the snapshot is not compressed", func() {
+			_, _, err := snapshot.Load(bytes.NewBufferString("foo"))
+			Expect(err).To(MatchError(ContainSubstring("snapshot is not gzip compressed")))
+		})
+
+		It("should return an error when an objects file is invalid", func() {
+			buffer := writeTarball(map[string]string{
+				snapshot.MetadataFile: `{"time":"2025-05-01T10:00:00Z"}`,
+				"objects/v1/pod.json": `[`,
+			})
+
+			_, _, err := snapshot.Load(buffer)
+			Expect(err).To(MatchError(ContainSubstring("invalid snapshot file objects/v1/pod.json")))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
@@ -58,6 +59,27 @@ func GetObjectsMetadata(ctx context.Context, c client.Client, gvk schema.GroupVe
 	}
 }
 
+// GetObjects returns all resources of a given list group version kind for a namespace,
+// or all namespaces if it's set to "".
+// It retrieves objects by portions set by limit.
+func GetObjects(ctx context.Context, c client.Client, listGVK schema.GroupVersionKind, namespace string, selector labels.Selector, limit int64) ([]unstructured.Unstructured, error) {
+	objectList := &unstructured.UnstructuredList{}
+	objectList.SetGroupVersionKind(listGVK)
+	var objects []unstructured.Unstructured
+
+	for {
+		if err := c.List(ctx, objectList, client.InNamespace(namespace), client.Limit(limit), client.MatchingLabelsSelector{Selector: selector}, client.Continue(objectList.GetContinue())); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, objectList.Items...)
+
+		if len(objectList.GetContinue()) == 0 {
+			return objects, nil
+		}
+	}
+}
+
 // GetAllObjectsMetadata returns the object metadata for resources returned by
 // 'kubectl get all' in a given namespace or all namespaces if it's set to "".
 // It retrieves objects by portions set by limit.
@@ -142,6 +142,51 @@ var _ = Describe("utils", func() {
 		})
 	})
 
+	Describe("#GetObjects", func() {
+		var (
+			fakeClient client.Client
+			ctx        = context.TODO()
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().Build()
+			for i := 0; i < 5; i++ {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      strconv.Itoa(i),
+						Namespace: "default",
+						Labels: map[string]string{
+							"index": strconv.Itoa(i % 2),
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "test",
+							},
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+			}
+		})
+
+		It("should return the full objects by portions", func() {
+			pods, err := utils.GetObjects(ctx, fakeClient, corev1.SchemeGroupVersion.WithKind("PodList"), "", labels.NewSelector(), 2)
+
+			Expect(err).To(BeNil())
+			Expect(len(pods)).To(Equal(5))
+			Expect(pods[0].Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("containers", ConsistOf(HaveKeyWithValue("name", "test")))))
+		})
+
+		It("should return the labeled objects", func() {
+			pods, err := utils.GetObjects(ctx, fakeClient, corev1.SchemeGroupVersion.WithKind("PodList"), "default", labels.SelectorFromSet(labels.Set{"index": "1"}), 2)
+
+			Expect(err).To(BeNil())
+			Expect(len(pods)).To(Equal(2))
+		})
+	})
+
 	Describe("#GetAllObjectsMetadata", func() {
 		var (
 			fakeClient              client.Client
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"encoding/json"
+	"maps"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Rule is used to represent a specific rule and it's metadata.
+type Rule struct {
+	// ID is the unique identifier of the rule in the ruleset.
+	ID string `json:"id"`
+	// Name is the user-friendly name of the rule.
+	Name string `json:"name"`
+	// Severity is the severity level of the rule.
+	Severity rule.SeverityLevel `json:"severity,omitempty"`
+	// Labels are the labels of the rule, without its severity.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Implemented shows if the rule performs checks. Rules that are skipped by design
+	// or not implemented report a predefined status and justification instead.
+	Implemented bool `json:"implemented"`
+	// Status is the predefined status reported by a rule that is not implemented.
+	Status rule.Status `json:"status,omitempty"`
+	// Justification is the predefined justification reported by a rule that is not implemented.
+	Justification string `json:"justification,omitempty"`
+	// Configurable shows if the rule accepts arguments.
+	Configurable bool `json:"configurable"`
+	// ArgsSchema is the JSON Schema of the rule arguments.
+	ArgsSchema json.RawMessage `json:"argsSchema,omitempty"`
+}
+
+// RulesetDetailed is used to represent a specific ruleset version and its rules.
+type RulesetDetailed struct {
+	// ID is the unique identifier of the ruleset.
+	ID string `json:"id"`
+	// Name is the user-friendly name of the ruleset.
+	Name string `json:"name"`
+	// Version is the version of the ruleset.
+	Version string `json:"version"`
+	// Rules are the rules of the ruleset version.
+	Rules []Rule `json:"rules"`
+}
+
+// NewRule returns the metadata of a rule. argsSchema is the JSON Schema
+// of the rule arguments and should be empty if the rule does not accept any.
+func NewRule(r rule.Rule, argsSchema json.RawMessage) Rule {
+	ruleMetadata := Rule{
+		ID:           r.ID(),
+		Name:         r.Name(),
+		Implemented:  true,
+		Configurable: len(argsSchema) > 0,
+		ArgsSchema:   argsSchema,
+	}
+
+	if s, ok := r.(rule.Severity); ok {
+		ruleMetadata.Severity = s.Severity()
+	}
+
+	if l, ok := r.(rule.Labels); ok && len(l.Labels()) > 0 {
+		ruleMetadata.Labels = maps.Clone(l.Labels())
+		delete(ruleMetadata.Labels, rule.LabelSeverity)
+	}
+
+	if skipRule, ok := r.(*rule.SkipRule); ok {
+		ruleMetadata.Implemented = false
+		ruleMetadata.Status = skipRule.Status()
+		ruleMetadata.Justification = skipRule.Justification()
+	}
+	return ruleMetadata
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+)
+
+type simpleRule struct{}
+
+func (r *simpleRule) ID() string                                   { return "1" }
+func (r *simpleRule) Name() string                                 { return "foo" }
+func (r *simpleRule) Severity() rule.SeverityLevel                 { return rule.SeverityHigh }
+func (r *simpleRule) Run(context.Context) (rule.RuleResult, error) { return rule.RuleResult{}, nil }
+
+var _ = Describe("Rule", func() {
+	Describe("#NewRule", func() {
+		It("should describe an implemented rule", func() {
+			argsSchema := json.RawMessage(`{"type":"object"}`)
+			r := retry.New(retry.WithBaseRule(&simpleRule{}))
+
+			Expect(metadata.NewRule(r, argsSchema)).To(Equal(metadata.Rule{
+				ID:           "1",
+				Name:         "foo",
+				Severity:     rule.SeverityHigh,
+				Implemented:  true,
+				Configurable: true,
+				ArgsSchema:   argsSchema,
+			}))
+		})
+
+		It("should describe a rule that is skipped by design", func() {
+			r := rule.NewSkipRule("2", "bar", "not relevant", rule.Skipped,
+				rule.SkipRuleWithSeverity(rule.SeverityLow),
+				rule.SkipRuleWithLabels(map[string]string{"foo": "bar"}),
+			)
+
+			Expect(metadata.NewRule(r, nil)).To(Equal(metadata.Rule{
+				ID:            "2",
+				Name:          "bar",
+				Severity:      rule.SeverityLow,
+				Labels:        map[string]string{"foo": "bar"},
+				Status:        rule.Skipped,
+				Justification: "not relevant",
+			}))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/rego"
+)
+
+var _ = Describe("ArgsTypes", func() {
+	DescribeTable("should describe the rules that accept arguments",
+		func(metadataFunc provider.MetadataFunc, rulesFunc provider.RulesFromConfigFunc, argsTypesFunc provider.ArgsTypesFunc) {
+			argsTypes := argsTypesFunc()
+			Expect(argsTypes.Args).NotTo(BeNil())
+
+			for _, rulesetMetadata := range metadataFunc().Rulesets {
+				Expect(argsTypes.Rulesets).To(HaveKey(rulesetMetadata.ID))
+				// the rules of the rego ruleset are loaded from the policies in the directory of its args
+				if rulesetMetadata.ID == rego.RulesetID {
+					continue
+				}
+				for _, version := range rulesetMetadata.Versions {
+					Expect(argsTypes.Rulesets[rulesetMetadata.ID]).To(HaveKey(version.Version))
+					rulesetArgsTypes := argsTypes.Rulesets[rulesetMetadata.ID][version.Version]
+					ruleArgs := rulesetArgsTypes.RuleArgs
+
+					rulesetConfig := config.RulesetConfig{
+						ID:      rulesetMetadata.ID,
+						Version: version.Version,
+						Args:    rulesetArgsTypes.InspectArgs,
+					}
+					rules, err := rulesFunc(rulesetConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					for ruleID := range ruleArgs {
+						Expect(slices.ContainsFunc(rules, func(r rule.Rule) bool { return r.ID() == ruleID })).To(BeTrue(),
+							"rule %s does not exist in ruleset %s version %s", ruleID, rulesetMetadata.ID, version.Version)
+					}
+
+					for _, r := range rules {
+						rulesetConfig.RuleOptions = []config.RuleOptionsConfig{{RuleID: r.ID(), Args: "invalid"}}
+						_, err := rulesFunc(rulesetConfig)
+						if _, ok := ruleArgs[r.ID()]; ok {
+							Expect(err).To(HaveOccurred(), "rule %s of ruleset %s version %s does not parse its described arguments", r.ID(), rulesetMetadata.ID, version.Version)
+						} else {
+							Expect(err).NotTo(HaveOccurred(), "rule %s of ruleset %s version %s parses arguments that are not described", r.ID(), rulesetMetadata.ID, version.Version)
+						}
+					}
+				}
+			}
+		},
+		Entry("garden", provider.MetadataFunc(builder.GardenProviderMetadata), provider.RulesFromConfigFunc(builder.GardenRulesFromConfig), provider.ArgsTypesFunc(builder.GardenArgsTypes)),
+		Entry("gardener", provider.MetadataFunc(builder.GardenerProviderMetadata), provider.RulesFromConfigFunc(builder.GardenerRulesFromConfig), provider.ArgsTypesFunc(builder.GardenerArgsTypes)),
+		Entry("managedk8s", provider.MetadataFunc(builder.ManagedK8SProviderMetadata), provider.RulesFromConfigFunc(builder.ManagedK8SRulesFromConfig), provider.ArgsTypesFunc(builder.ManagedK8SArgsTypes)),
+		Entry("snapshot", provider.MetadataFunc(builder.SnapshotProviderMetadata), provider.RulesFromConfigFunc(builder.SnapshotRulesFromConfig), provider.ArgsTypesFunc(builder.SnapshotArgsTypes)),
+		Entry("virtualgarden", provider.MetadataFunc(builder.VirtualGardenProviderMetadata), provider.RulesFromConfigFunc(builder.VirtualGardenRulesFromConfig), provider.ArgsTypesFunc(builder.VirtualGardenArgsTypes)),
+	)
+})
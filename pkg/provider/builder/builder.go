@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"fmt"
+	"log/slog"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom"
+	"github.com/gardener/diki/pkg/shared/ruleset/plugin"
+	"github.com/gardener/diki/pkg/shared/ruleset/rego"
+)
+
+// init registers the providers and rulesets of diki in the default registry.
+func init() {
+	Register(registry.Default)
+}
+
+// Register registers the providers and rulesets of diki in a registry.
+func Register(r *registry.Registry) {
+	registerGarden(r)
+	registerGardener(r)
+	registerManagedK8S(r)
+	registerSnapshot(r)
+	registerVirtualGarden(r)
+}
+
+// providerAs returns the provider as type T, which is the type of the provider with the given id.
+func providerAs[T provider.Provider](p provider.Provider, providerID string) (T, error) {
+	typedProvider, ok := p.(T)
+	if !ok {
+		return typedProvider, fmt.Errorf("unexpected provider type %T, expected a %s provider", p, providerID)
+	}
+	return typedProvider, nil
+}
+
+// registerSharedRulesets registers the rulesets that are supported by all providers of a single cluster.
+// The clusterFunc returns the config and the kubeconfig path of the cluster of the provider.
+func registerSharedRulesets(r *registry.Registry, providerID string, clusterFunc func(p provider.Provider) (*rest.Config, string, error)) {
+	r.MustRegisterRuleset(providerID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: custom.RulesetID, Name: custom.RulesetName, Versions: registry.Versions(custom.SupportedVersions)},
+		Args:     custom.Args{},
+		RuleArgs: custom.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			restConfig, _, err := clusterFunc(p)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := custom.FromGenericConfig(conf, restConfig)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerCustom := custom.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerCustom(ruleset)
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			return custom.FromGenericConfig(conf, nil, custom.WithInspectionOnly())
+		},
+	})
+
+	r.MustRegisterRuleset(providerID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: rego.RulesetID, Name: rego.RulesetName, Versions: registry.Versions(rego.SupportedVersions)},
+		Args:     rego.Args{},
+		RuleArgs: rego.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			restConfig, _, err := clusterFunc(p)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := rego.FromGenericConfig(conf, restConfig)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerRego := rego.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerRego(ruleset)
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			return rego.FromGenericConfig(conf, nil, rego.WithInspectionOnly())
+		},
+	})
+
+	r.MustRegisterRuleset(providerID, registry.Ruleset{
+		Ruleset:     metadata.Ruleset{ID: plugin.RulesetID, Name: plugin.RulesetName, Versions: registry.Versions(plugin.SupportedVersions)},
+		Args:        plugin.Args{},
+		AnyRuleArgs: true,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			_, kubeconfigPath, err := clusterFunc(p)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := plugin.FromGenericConfig(conf, kubeconfigPath)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerPlugin := plugin.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerPlugin(ruleset)
+			return ruleset, nil
+		},
+	})
+}
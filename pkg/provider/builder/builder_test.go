@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/garden"
+	"github.com/gardener/diki/pkg/provider/managedk8s"
+)
+
+var _ = Describe("Builder", func() {
+	Describe("#providerAs", func() {
+		It("should return the provider if it has the expected type", func() {
+			p := &garden.Provider{}
+
+			gardenProvider, err := builder.GardenProviderAs(p, garden.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gardenProvider).To(BeIdenticalTo(p))
+		})
+
+		It("should return an error if the provider has an unexpected type", func() {
+			_, err := builder.GardenProviderAs(&managedk8s.Provider{}, garden.ProviderID)
+			Expect(err).To(MatchError("unexpected provider type *managedk8s.Provider, expected a garden provider"))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"github.com/gardener/diki/pkg/provider/garden"
+)
+
+var GardenProviderAs = providerAs[*garden.Provider]
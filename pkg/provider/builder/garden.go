@@ -5,90 +5,91 @@
 package builder
 
 import (
-	"fmt"
 	"log/slog"
 
+	"k8s.io/client-go/rest"
+
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/provider/garden"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
-// GardenProviderFromConfig retuns a Provider from a [ProviderConfig].
-func GardenProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
-	p, err := garden.FromGenericConfig(conf)
-	if err != nil {
-		return nil, err
-	}
+// registerGarden registers the Garden provider and its rulesets.
+func registerGarden(r *registry.Registry) {
+	r.MustRegisterProvider(registry.Provider{
+		Provider: metadata.Provider{ID: garden.ProviderID, Name: garden.ProviderName},
+		Args:     garden.ConfigArgs{},
+		FromConfig: func(conf config.ProviderConfig, logger *slog.Logger) (registry.RulesetProvider, error) {
+			p, err := garden.FromGenericConfig(conf)
+			if err != nil {
+				return nil, err
+			}
 
-	setConfigDefaults(p.Config)
-	providerLogger := slog.Default().With("provider", p.ID())
-	setLoggerFunc := garden.WithLogger(providerLogger)
-	setLoggerFunc(p)
-	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
-	for _, rulesetConfig := range conf.Rulesets {
-		switch rulesetConfig.ID {
-		case securityhardenedshoot.RulesetID:
-			ruleset, err := securityhardenedshoot.FromGenericConfig(rulesetConfig, p.Config, providerLogger)
+			setConfigDefaults(p.Config)
+			setLoggerFunc := garden.WithLogger(logger)
+			setLoggerFunc(p)
+			return p, nil
+		},
+		Offline: func() (registry.RulesetProvider, error) {
+			// the provider has no config, its rulesets are inspected without the clients of the cluster
+			return &garden.Provider{}, nil
+		},
+	})
+
+	r.MustRegisterRuleset(garden.ProviderID, registry.Ruleset{
+		Ruleset:     metadata.Ruleset{ID: securityhardenedshoot.RulesetID, Name: securityhardenedshoot.RulesetName, Versions: registry.Versions(securityhardenedshoot.SupportedVersions)},
+		Args:        securityhardenedshoot.Args{},
+		InspectArgs: securityhardenedshoot.Args{ProjectNamespace: "garden-project", ShootName: "shoot"},
+		RuleArgs:    securityhardenedshoot.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			gardenProvider, err := providerAs[*garden.Provider](p, garden.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := securityhardenedshoot.FromGenericConfig(conf, gardenProvider.Config, logger)
 			if err != nil {
 				return nil, err
 			}
-			setLoggerHardened := securityhardenedshoot.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerHardened := securityhardenedshoot.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerHardened(ruleset)
-			rulesets = append(rulesets, ruleset)
-		default:
-			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			return securityhardenedshoot.FromGenericConfig(conf, nil, logger, securityhardenedshoot.WithInspectionOnly())
+		},
+	})
+
+	registerSharedRulesets(r, garden.ProviderID, func(p provider.Provider) (*rest.Config, string, error) {
+		gardenProvider, err := providerAs[*garden.Provider](p, garden.ProviderID)
+		if err != nil {
+			return nil, "", err
 		}
-	}
+		return gardenProvider.Config, gardenProvider.KubeconfigPath, nil
+	})
+}
 
-	if err := p.AddRulesets(rulesets...); err != nil {
-		return nil, err
-	}
+// GardenProviderFromConfig retuns a Provider from a [ProviderConfig].
+func GardenProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
+	return registry.Default.ProviderFromConfig(garden.ProviderID, conf)
+}
 
-	return p, nil
+// GardenRulesFromConfig returns the Rules of a ruleset supported by the Garden provider
+// without connecting to a cluster. The returned Rules can be inspected, but should not be run.
+func GardenRulesFromConfig(conf config.RulesetConfig) ([]rule.Rule, error) {
+	return registry.Default.RulesFromConfig(garden.ProviderID, conf)
 }
 
-// gardenGetSupportedVersions returns the Supported Versions of a specific ruleset that is supported by the Garden provider.
-func gardenGetSupportedVersions(ruleset string) []string {
-	switch ruleset {
-	case securityhardenedshoot.RulesetID:
-		return securityhardenedshoot.SupportedVersions
-	default:
-		return nil
-	}
+// GardenArgsTypes returns the argument types accepted in the configuration of the Garden provider and its rulesets.
+func GardenArgsTypes() provider.ArgsTypes {
+	return registry.Default.ProviderOption(garden.ProviderID).ArgsTypesFunc()
 }
 
 // GardenProviderMetadata returns available metadata for the Garden Provider and it's supported rulesets.
 func GardenProviderMetadata() metadata.ProviderDetailed {
-	providerMetadata := metadata.ProviderDetailed{
-		Provider: metadata.Provider{
-			ID:   garden.ProviderID,
-			Name: garden.ProviderName,
-		},
-		Rulesets: []metadata.Ruleset{
-			{
-				ID:   securityhardenedshoot.RulesetID,
-				Name: securityhardenedshoot.RulesetName,
-			},
-		},
-	}
-
-	for i := range providerMetadata.Rulesets {
-		supportedVersions := gardenGetSupportedVersions(providerMetadata.Rulesets[i].ID)
-		for _, supportedVersion := range supportedVersions {
-			providerMetadata.Rulesets[i].Versions = append(
-				providerMetadata.Rulesets[i].Versions,
-				metadata.Version{Version: supportedVersion, Latest: false},
-			)
-		}
-
-		// Mark the first version as latest as the versions are sorted from newest to oldest
-		if len(providerMetadata.Rulesets[i].Versions) > 0 {
-			providerMetadata.Rulesets[i].Versions[0].Latest = true
-		}
-	}
-
-	return providerMetadata
+	return registry.Default.ProviderOption(garden.ProviderID).MetadataFunc()
 }
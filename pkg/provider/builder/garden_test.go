@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"log/slog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot"
+)
+
+var _ = Describe("Garden", func() {
+	It("should inspect the security hardened shoot ruleset with the configured args", func() {
+		rulesetConfig := config.RulesetConfig{
+			ID:      securityhardenedshoot.RulesetID,
+			Version: securityhardenedshoot.SupportedVersions[0],
+			Args:    map[string]any{"foo": 1},
+		}
+
+		_, err := builder.GardenRulesFromConfig(rulesetConfig)
+		Expect(err).To(MatchError("ruleset args projectNamespace should not be empty"))
+
+		rulesetConfig.Args = map[string]any{"projectNamespace": "garden-foo", "shootName": "bar"}
+		rules, err := builder.GardenRulesFromConfig(rulesetConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).NotTo(BeEmpty())
+	})
+
+	It("should not create the security hardened shoot ruleset without a cluster config unless it is only inspected", func() {
+		rulesetConfig := config.RulesetConfig{
+			ID:      securityhardenedshoot.RulesetID,
+			Version: securityhardenedshoot.SupportedVersions[0],
+			Args:    map[string]any{"projectNamespace": "garden-foo", "shootName": "bar"},
+		}
+		logger := slog.New(slog.DiscardHandler)
+
+		_, err := securityhardenedshoot.FromGenericConfig(rulesetConfig, nil, logger)
+		Expect(err).To(MatchError("cluster config is nil"))
+
+		ruleset, err := securityhardenedshoot.FromGenericConfig(rulesetConfig, nil, logger, securityhardenedshoot.WithInspectionOnly())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleset.Rules()).NotTo(BeEmpty())
+	})
+})
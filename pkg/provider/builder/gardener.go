@@ -5,7 +5,6 @@
 package builder
 
 import (
-	"fmt"
 	"log/slog"
 
 	"k8s.io/client-go/rest"
@@ -15,93 +14,88 @@ import (
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/provider/gardener"
 	"github.com/gardener/diki/pkg/provider/gardener/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
-// GardenerProviderFromConfig retuns a Provider from a ProviderConfig.
-func GardenerProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
-	p, err := gardener.FromGenericConfig(conf)
-	if err != nil {
-		return nil, err
-	}
-
-	setConfigDefaults(p.ShootConfig)
-	setConfigDefaults(p.SeedConfig)
-	providerLogger := slog.Default().With("provider", p.ID())
-	setLoggerFunc := gardener.WithLogger(providerLogger)
-	setLoggerFunc(p)
-	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
-	for _, rulesetConfig := range conf.Rulesets {
-		switch rulesetConfig.ID {
-		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.ShootConfig, p.SeedConfig, p.Args.ShootNamespace)
+// registerGardener registers the Gardener provider and its rulesets.
+func registerGardener(r *registry.Registry) {
+	r.MustRegisterProvider(registry.Provider{
+		Provider: metadata.Provider{ID: gardener.ProviderID, Name: gardener.ProviderName},
+		Args:     gardener.ConfigArgs{},
+		FromConfig: func(conf config.ProviderConfig, logger *slog.Logger) (registry.RulesetProvider, error) {
+			p, err := gardener.FromGenericConfig(conf)
 			if err != nil {
 				return nil, err
 			}
-			setLoggerDISA := disak8sstig.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
-			setLoggerDISA(ruleset)
-			rulesets = append(rulesets, ruleset)
-		default:
-			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
-		}
-	}
 
-	if err := p.AddRulesets(rulesets...); err != nil {
-		return nil, err
-	}
+			setConfigDefaults(p.ShootConfig)
+			setConfigDefaults(p.SeedConfig)
+			setLoggerFunc := gardener.WithLogger(logger)
+			setLoggerFunc(p)
+			return p, nil
+		},
+		Offline: func() (registry.RulesetProvider, error) {
+			// the provider has no configs, its rulesets are inspected without the clients of the clusters
+			return &gardener.Provider{Args: gardener.Args{ShootName: "shoot", ShootNamespace: "shoot--project--shoot"}}, nil
+		},
+	})
 
-	return p, nil
+	r.MustRegisterRuleset(gardener.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: disak8sstig.RulesetID, Name: disak8sstig.RulesetName, Versions: registry.Versions(disak8sstig.SupportedVersions)},
+		Args:     disak8sstig.Args{},
+		RuleArgs: disak8sstig.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			gardenerProvider, err := providerAs[*gardener.Provider](p, gardener.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := disak8sstig.FromGenericConfig(conf, gardenerProvider.AdditionalOpsPodLabels, gardenerProvider.ShootConfig, gardenerProvider.SeedConfig, gardenerProvider.Args.ShootNamespace)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerDISA := disak8sstig.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerDISA(ruleset)
+			return ruleset, nil
+		},
+		Inspect: func(p provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			gardenerProvider, err := providerAs[*gardener.Provider](p, gardener.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			return disak8sstig.FromGenericConfig(conf, nil, nil, nil, gardenerProvider.Args.ShootNamespace, disak8sstig.WithInspectionOnly())
+		},
+	})
 }
 
-func setConfigDefaults(config *rest.Config) {
-	if config.QPS <= 0 {
-		config.QPS = 20
-	}
+// GardenerProviderFromConfig retuns a Provider from a ProviderConfig.
+func GardenerProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
+	return registry.Default.ProviderFromConfig(gardener.ProviderID, conf)
+}
 
-	if config.Burst <= 0 {
-		config.Burst = 40
-	}
+// GardenerRulesFromConfig returns the Rules of a ruleset supported by the Gardener provider
+// without connecting to a cluster. The returned Rules can be inspected, but should not be run.
+func GardenerRulesFromConfig(conf config.RulesetConfig) ([]rule.Rule, error) {
+	return registry.Default.RulesFromConfig(gardener.ProviderID, conf)
 }
 
-// gardenerGetSupportedVersions returns the Supported Versions of a specific ruleset that is supported by the Gardener provider.
-func gardenerGetSupportedVersions(ruleset string) []string {
-	switch ruleset {
-	case disak8sstig.RulesetID:
-		return disak8sstig.SupportedVersions
-	default:
-		return nil
-	}
+// GardenerArgsTypes returns the argument types accepted in the configuration of the Gardener provider and its rulesets.
+func GardenerArgsTypes() provider.ArgsTypes {
+	return registry.Default.ProviderOption(gardener.ProviderID).ArgsTypesFunc()
 }
 
 // GardenerProviderMetadata returns available metadata for the Gardener Provider and it's supported rulesets.
 func GardenerProviderMetadata() metadata.ProviderDetailed {
-	providerMetadata := metadata.ProviderDetailed{
-		Provider: metadata.Provider{
-			ID:   gardener.ProviderID,
-			Name: gardener.ProviderName,
-		},
-		Rulesets: []metadata.Ruleset{
-			{
-				ID:   disak8sstig.RulesetID,
-				Name: disak8sstig.RulesetName,
-			},
-		},
-	}
-
-	for i := range providerMetadata.Rulesets {
-		supportedVersions := gardenerGetSupportedVersions(providerMetadata.Rulesets[i].ID)
-		for _, supportedVersion := range supportedVersions {
-			providerMetadata.Rulesets[i].Versions = append(
-				providerMetadata.Rulesets[i].Versions,
-				metadata.Version{Version: supportedVersion, Latest: false},
-			)
-		}
+	return registry.Default.ProviderOption(gardener.ProviderID).MetadataFunc()
+}
 
-		// Mark the first version as latest as the versions are sorted from newest to oldest
-		if len(providerMetadata.Rulesets[i].Versions) > 0 {
-			providerMetadata.Rulesets[i].Versions[0].Latest = true
-		}
+func setConfigDefaults(config *rest.Config) {
+	if config.QPS <= 0 {
+		config.QPS = 20
 	}
 
-	return providerMetadata
+	if config.Burst <= 0 {
+		config.Burst = 40
+	}
 }
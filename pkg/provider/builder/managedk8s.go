@@ -5,105 +5,112 @@
 package builder
 
 import (
-	"fmt"
 	"log/slog"
 
+	"k8s.io/client-go/rest"
+
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/provider/managedk8s"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
-// ManagedK8SProviderFromConfig retuns a Provider from a [ProviderConfig].
-func ManagedK8SProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
-	p, err := managedk8s.FromGenericConfig(conf)
-	if err != nil {
-		return nil, err
-	}
+// registerManagedK8S registers the Managed Kubernetes provider and its rulesets.
+func registerManagedK8S(r *registry.Registry) {
+	r.MustRegisterProvider(registry.Provider{
+		Provider: metadata.Provider{ID: managedk8s.ProviderID, Name: managedk8s.ProviderName},
+		Args:     managedk8s.ConfigArgs{},
+		FromConfig: func(conf config.ProviderConfig, logger *slog.Logger) (registry.RulesetProvider, error) {
+			p, err := managedk8s.FromGenericConfig(conf)
+			if err != nil {
+				return nil, err
+			}
+
+			setConfigDefaults(p.Config)
+			setLoggerFunc := managedk8s.WithLogger(logger)
+			setLoggerFunc(p)
+			return p, nil
+		},
+		Offline: func() (registry.RulesetProvider, error) {
+			// the provider has no config, its rulesets are inspected without the clients of the cluster
+			return &managedk8s.Provider{}, nil
+		},
+	})
 
-	setConfigDefaults(p.Config)
-	providerLogger := slog.Default().With("provider", p.ID())
-	setLoggerFunc := managedk8s.WithLogger(providerLogger)
-	setLoggerFunc(p)
-	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
-	for _, rulesetConfig := range conf.Rulesets {
-		switch rulesetConfig.ID {
-		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.Config)
+	r.MustRegisterRuleset(managedk8s.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: securityhardenedk8s.RulesetID, Name: securityhardenedk8s.RulesetName, Versions: registry.Versions(securityhardenedk8s.SupportedVersions)},
+		RuleArgs: securityhardenedk8s.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			managedk8sProvider, err := providerAs[*managedk8s.Provider](p, managedk8s.ProviderID)
 			if err != nil {
 				return nil, err
 			}
-			setLoggerDISA := disak8sstig.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
-			setLoggerDISA(ruleset)
-			rulesets = append(rulesets, ruleset)
-		case securityhardenedk8s.RulesetID:
-			ruleset, err := securityhardenedk8s.FromGenericConfig(rulesetConfig, p.Config)
+			ruleset, err := securityhardenedk8s.FromGenericConfig(conf, managedk8sProvider.Config)
 			if err != nil {
 				return nil, err
 			}
-			setLoggerHardened := securityhardenedk8s.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerHardened := securityhardenedk8s.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerHardened(ruleset)
-			rulesets = append(rulesets, ruleset)
-		default:
-			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			return securityhardenedk8s.FromGenericConfig(conf, nil, securityhardenedk8s.WithInspectionOnly())
+		},
+	})
+
+	r.MustRegisterRuleset(managedk8s.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: disak8sstig.RulesetID, Name: disak8sstig.RulesetName, Versions: registry.Versions(disak8sstig.SupportedVersions)},
+		Args:     disak8sstig.Args{},
+		RuleArgs: disak8sstig.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			managedk8sProvider, err := providerAs[*managedk8s.Provider](p, managedk8s.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := disak8sstig.FromGenericConfig(conf, managedk8sProvider.AdditionalOpsPodLabels, managedk8sProvider.Config)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerDISA := disak8sstig.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerDISA(ruleset)
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			return disak8sstig.FromGenericConfig(conf, nil, nil, disak8sstig.WithInspectionOnly())
+		},
+	})
+
+	registerSharedRulesets(r, managedk8s.ProviderID, func(p provider.Provider) (*rest.Config, string, error) {
+		managedk8sProvider, err := providerAs[*managedk8s.Provider](p, managedk8s.ProviderID)
+		if err != nil {
+			return nil, "", err
 		}
-	}
+		return managedk8sProvider.Config, managedk8sProvider.KubeconfigPath, nil
+	})
+}
 
-	if err := p.AddRulesets(rulesets...); err != nil {
-		return nil, err
-	}
+// ManagedK8SProviderFromConfig retuns a Provider from a [ProviderConfig].
+func ManagedK8SProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
+	return registry.Default.ProviderFromConfig(managedk8s.ProviderID, conf)
+}
 
-	return p, nil
+// ManagedK8SRulesFromConfig returns the Rules of a ruleset supported by the Managed Kubernetes provider
+// without connecting to a cluster. The returned Rules can be inspected, but should not be run.
+func ManagedK8SRulesFromConfig(conf config.RulesetConfig) ([]rule.Rule, error) {
+	return registry.Default.RulesFromConfig(managedk8s.ProviderID, conf)
 }
 
-// managedK8SGetSupportedVersions returns the supported versions of a specific ruleset that is supported by the Managed K8S provider.
-func managedK8SGetSupportedVersions(ruleset string) []string {
-	switch ruleset {
-	case securityhardenedk8s.RulesetID:
-		return securityhardenedk8s.SupportedVersions
-	case disak8sstig.RulesetID:
-		return disak8sstig.SupportedVersions
-	default:
-		return nil
-	}
+// ManagedK8SArgsTypes returns the argument types accepted in the configuration of the Managed K8S provider and its rulesets.
+func ManagedK8SArgsTypes() provider.ArgsTypes {
+	return registry.Default.ProviderOption(managedk8s.ProviderID).ArgsTypesFunc()
 }
 
 // ManagedK8SProviderMetadata returns available metadata for the Managed Kubernetes Provider and it's supported rulesets.
 func ManagedK8SProviderMetadata() metadata.ProviderDetailed {
-	providerMetadata := metadata.ProviderDetailed{
-		Provider: metadata.Provider{
-			ID:   managedk8s.ProviderID,
-			Name: managedk8s.ProviderName,
-		},
-		Rulesets: []metadata.Ruleset{
-			{
-				ID:   securityhardenedk8s.RulesetID,
-				Name: securityhardenedk8s.RulesetName,
-			},
-			{
-				ID:   disak8sstig.RulesetID,
-				Name: disak8sstig.RulesetName,
-			},
-		},
-	}
-
-	for i := range providerMetadata.Rulesets {
-		supportedVersions := managedK8SGetSupportedVersions(providerMetadata.Rulesets[i].ID)
-		for _, supportedVersion := range supportedVersions {
-			providerMetadata.Rulesets[i].Versions = append(
-				providerMetadata.Rulesets[i].Versions,
-				metadata.Version{Version: supportedVersion, Latest: false},
-			)
-		}
-
-		// Mark the first version as latest as the versions are sorted from newest to oldest
-		if len(providerMetadata.Rulesets[i].Versions) > 0 {
-			providerMetadata.Rulesets[i].Versions[0].Latest = true
-		}
-	}
-
-	return providerMetadata
+	return registry.Default.ProviderOption(managedk8s.ProviderID).MetadataFunc()
 }
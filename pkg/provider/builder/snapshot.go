@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"log/slog"
+
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/provider/snapshot"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// registerSnapshot registers the Snapshot provider and its rulesets.
+// The rulesets are the ones of the Managed Kubernetes provider, whose rules are run against the
+// objects of the snapshot. The rules that need a live cluster are reported as skipped.
+func registerSnapshot(r *registry.Registry) {
+	r.MustRegisterProvider(registry.Provider{
+		Provider: metadata.Provider{ID: snapshot.ProviderID, Name: snapshot.ProviderName},
+		Args:     snapshot.ConfigArgs{},
+		FromConfig: func(conf config.ProviderConfig, logger *slog.Logger) (registry.RulesetProvider, error) {
+			p, err := snapshot.FromGenericConfig(conf)
+			if err != nil {
+				return nil, err
+			}
+
+			setLoggerFunc := snapshot.WithLogger(logger)
+			setLoggerFunc(p)
+			return p, nil
+		},
+		Offline: func() (registry.RulesetProvider, error) {
+			return snapshot.New(snapshot.WithID(snapshot.ProviderID), snapshot.WithClient(fakeclient.NewClientBuilder().Build()))
+		},
+	})
+
+	r.MustRegisterRuleset(snapshot.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: securityhardenedk8s.RulesetID, Name: securityhardenedk8s.RulesetName, Versions: registry.Versions(securityhardenedk8s.SupportedVersions)},
+		RuleArgs: securityhardenedk8s.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			snapshotProvider, err := providerAs[*snapshot.Provider](p, snapshot.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			// the ruleset has no config, since its rules use the client of the snapshot or are skipped
+			ruleset, err := securityhardenedk8s.FromGenericConfig(
+				conf,
+				nil,
+				securityhardenedk8s.WithClient(snapshotProvider.Client),
+				securityhardenedk8s.WithSupportedRules(snapshot.SupportedRules[securityhardenedk8s.RulesetID], snapshot.UnsupportedRuleJustification),
+			)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerHardened := securityhardenedk8s.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerHardened(ruleset)
+			return ruleset, nil
+		},
+	})
+
+	r.MustRegisterRuleset(snapshot.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: disak8sstig.RulesetID, Name: disak8sstig.RulesetName, Versions: registry.Versions(disak8sstig.SupportedVersions)},
+		Args:     disak8sstig.Args{},
+		RuleArgs: disak8sstig.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			snapshotProvider, err := providerAs[*snapshot.Provider](p, snapshot.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			// the ruleset has no config, since its rules use the client of the snapshot or are skipped
+			ruleset, err := disak8sstig.FromGenericConfig(
+				conf,
+				nil,
+				nil,
+				disak8sstig.WithClient(snapshotProvider.Client),
+				disak8sstig.WithSupportedRules(snapshot.SupportedRules[disak8sstig.RulesetID], snapshot.UnsupportedRuleJustification),
+			)
+			if err != nil {
+				return nil, err
+			}
+			setLoggerDISA := disak8sstig.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerDISA(ruleset)
+			return ruleset, nil
+		},
+	})
+}
+
+// SnapshotProviderFromConfig returns a Provider from a [ProviderConfig].
+func SnapshotProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
+	return registry.Default.ProviderFromConfig(snapshot.ProviderID, conf)
+}
+
+// SnapshotRulesFromConfig returns the Rules of a ruleset supported by the Snapshot provider
+// without loading a snapshot. The returned Rules can be inspected, but should not be run.
+func SnapshotRulesFromConfig(conf config.RulesetConfig) ([]rule.Rule, error) {
+	return registry.Default.RulesFromConfig(snapshot.ProviderID, conf)
+}
+
+// SnapshotArgsTypes returns the argument types accepted in the configuration of the Snapshot provider and its rulesets.
+func SnapshotArgsTypes() provider.ArgsTypes {
+	return registry.Default.ProviderOption(snapshot.ProviderID).ArgsTypesFunc()
+}
+
+// SnapshotProviderMetadata returns available metadata for the Snapshot Provider and it's supported rulesets.
+func SnapshotProviderMetadata() metadata.ProviderDetailed {
+	return registry.Default.ProviderOption(snapshot.ProviderID).MetadataFunc()
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/config"
+	kubesnapshot "github.com/gardener/diki/pkg/kubernetes/snapshot"
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/provider/snapshot"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		ctx          context.Context
+		snapshotPath string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		kubeProxyPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-proxy",
+				Namespace: "kube-system",
+				Labels:    map[string]string{"role": "proxy"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "node",
+				Containers: []corev1.Container{{Name: "kube-proxy", Image: "kube-proxy:v1.32.0"}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "kube-proxy", ImageID: "eu.gcr.io/kube-proxy@sha256:3f7a50f38688eb332e2a1b013678c6435d539ae63f7a50f38688eb332e2a1b01"}},
+			},
+		}
+		c := fakeclient.NewClientBuilder().WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			kubeProxyPod,
+		).Build()
+
+		snapshotPath = filepath.Join(GinkgoT().TempDir(), "snapshot.tar.gz")
+		file, err := os.Create(snapshotPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kubesnapshot.Write(ctx, c, kubesnapshot.Metadata{Time: time.Date(2025, time.May, 1, 10, 0, 0, 0, time.UTC)}, file)).To(Succeed())
+		Expect(file.Close()).To(Succeed())
+	})
+
+	It("should run the supported rules against the snapshot and skip the other rules", func() {
+		p, err := builder.SnapshotProviderFromConfig(config.ProviderConfig{
+			ID:   snapshot.ProviderID,
+			Name: snapshot.ProviderName,
+			Args: map[string]any{"snapshotPath": snapshotPath},
+			Rulesets: []config.RulesetConfig{
+				{ID: disak8sstig.RulesetID, Version: "v2r3"},
+				{ID: securityhardenedk8s.RulesetID, Version: "v0.1.0"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Metadata()).To(HaveKeyWithValue(snapshot.MetadataSnapshotTime, "2025-05-01T10:00:00Z"))
+
+		result, err := p.RunRule(ctx, disak8sstig.RulesetID, "v2r3", "242442")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.CheckResults).To(Equal([]rule.CheckResult{rule.PassedCheckResult("All found images use current versions.", rule.Target{})}))
+
+		result, err = p.RunRule(ctx, disak8sstig.RulesetID, "v2r3", "242447")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.CheckResults).To(Equal([]rule.CheckResult{rule.SkippedCheckResult(snapshot.UnsupportedRuleJustification, nil)}))
+
+		result, err = p.RunRule(ctx, securityhardenedk8s.RulesetID, "v0.1.0", "2002")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.CheckResults).NotTo(BeEmpty())
+		Expect(result.CheckResults[0].Status).NotTo(Equal(rule.Skipped))
+		Expect(result.CheckResults[0].Status).NotTo(Equal(rule.Errored))
+	})
+
+	It("should return an error when the snapshot does not exist", func() {
+		_, err := builder.SnapshotProviderFromConfig(config.ProviderConfig{
+			ID:   snapshot.ProviderID,
+			Args: map[string]any{"snapshotPath": filepath.Join(GinkgoT().TempDir(), "missing.tar.gz")},
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to load snapshot")))
+	})
+})
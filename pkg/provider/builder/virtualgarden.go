@@ -5,90 +5,80 @@
 package builder
 
 import (
-	"fmt"
 	"log/slog"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/registry"
 	"github.com/gardener/diki/pkg/provider/virtualgarden"
 	"github.com/gardener/diki/pkg/provider/virtualgarden/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
-// VirtualGardenProviderFromConfig retuns a Provider from a [ProviderConfig].
-func VirtualGardenProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
-	p, err := virtualgarden.FromGenericConfig(conf)
-	if err != nil {
-		return nil, err
-	}
+// registerVirtualGarden registers the Virtual Garden provider and its rulesets.
+func registerVirtualGarden(r *registry.Registry) {
+	r.MustRegisterProvider(registry.Provider{
+		Provider: metadata.Provider{ID: virtualgarden.ProviderID, Name: virtualgarden.ProviderName},
+		Args:     virtualgarden.ConfigArgs{},
+		FromConfig: func(conf config.ProviderConfig, logger *slog.Logger) (registry.RulesetProvider, error) {
+			p, err := virtualgarden.FromGenericConfig(conf)
+			if err != nil {
+				return nil, err
+			}
 
-	setConfigDefaults(p.RuntimeConfig)
-	providerLogger := slog.Default().With("provider", p.ID())
-	setLoggerFunc := virtualgarden.WithLogger(providerLogger)
-	setLoggerFunc(p)
-	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
-	for _, rulesetConfig := range conf.Rulesets {
-		switch rulesetConfig.ID {
-		case disak8sstig.RulesetID:
-			ruleset, err := disak8sstig.FromGenericConfig(rulesetConfig, p.AdditionalOpsPodLabels, p.RuntimeConfig)
+			setConfigDefaults(p.RuntimeConfig)
+			setLoggerFunc := virtualgarden.WithLogger(logger)
+			setLoggerFunc(p)
+			return p, nil
+		},
+		Offline: func() (registry.RulesetProvider, error) {
+			// the provider has no config, its rulesets are inspected without the clients of the cluster
+			return &virtualgarden.Provider{}, nil
+		},
+	})
+
+	r.MustRegisterRuleset(virtualgarden.ProviderID, registry.Ruleset{
+		Ruleset:  metadata.Ruleset{ID: disak8sstig.RulesetID, Name: disak8sstig.RulesetName, Versions: registry.Versions(disak8sstig.SupportedVersions)},
+		Args:     disak8sstig.Args{},
+		RuleArgs: disak8sstig.RuleArgs,
+		FromConfig: func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error) {
+			virtualGardenProvider, err := providerAs[*virtualgarden.Provider](p, virtualgarden.ProviderID)
+			if err != nil {
+				return nil, err
+			}
+			ruleset, err := disak8sstig.FromGenericConfig(conf, virtualGardenProvider.AdditionalOpsPodLabels, virtualGardenProvider.RuntimeConfig)
 			if err != nil {
 				return nil, err
 			}
-			setLoggerDISA := disak8sstig.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
+			setLoggerDISA := disak8sstig.WithLogger(logger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
 			setLoggerDISA(ruleset)
-			rulesets = append(rulesets, ruleset)
-		default:
-			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
-		}
-	}
+			return ruleset, nil
+		},
+		Inspect: func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			return disak8sstig.FromGenericConfig(conf, nil, nil, disak8sstig.WithInspectionOnly())
+		},
+	})
+}
 
-	if err := p.AddRulesets(rulesets...); err != nil {
-		return nil, err
-	}
+// VirtualGardenProviderFromConfig retuns a Provider from a [ProviderConfig].
+func VirtualGardenProviderFromConfig(conf config.ProviderConfig) (provider.Provider, error) {
+	return registry.Default.ProviderFromConfig(virtualgarden.ProviderID, conf)
+}
 
-	return p, nil
+// VirtualGardenRulesFromConfig returns the Rules of a ruleset supported by the Virtual Garden provider
+// without connecting to a cluster. The returned Rules can be inspected, but should not be run.
+func VirtualGardenRulesFromConfig(conf config.RulesetConfig) ([]rule.Rule, error) {
+	return registry.Default.RulesFromConfig(virtualgarden.ProviderID, conf)
 }
 
-// virtualGardenGetSupportedVersions returns the supported versions of a specific ruleset that is supported by the Virtual Garden provider.
-func virtualGardenGetSupportedVersions(ruleset string) []string {
-	switch ruleset {
-	case disak8sstig.RulesetID:
-		return disak8sstig.SupportedVersions
-	default:
-		return nil
-	}
+// VirtualGardenArgsTypes returns the argument types accepted in the configuration of the Virtual Garden provider and its rulesets.
+func VirtualGardenArgsTypes() provider.ArgsTypes {
+	return registry.Default.ProviderOption(virtualgarden.ProviderID).ArgsTypesFunc()
 }
 
 // VirtualGardenProviderMetadata returns available metadata for the Virtual Garden Provider and it's supported rulesets.
 func VirtualGardenProviderMetadata() metadata.ProviderDetailed {
-	providerMetadata := metadata.ProviderDetailed{
-		Provider: metadata.Provider{
-			ID:   virtualgarden.ProviderID,
-			Name: virtualgarden.ProviderName,
-		},
-		Rulesets: []metadata.Ruleset{
-			{
-				ID:   disak8sstig.RulesetID,
-				Name: disak8sstig.RulesetName,
-			},
-		},
-	}
-
-	for i := range providerMetadata.Rulesets {
-		supportedVersions := virtualGardenGetSupportedVersions(providerMetadata.Rulesets[i].ID)
-		for _, supportedVersion := range supportedVersions {
-			providerMetadata.Rulesets[i].Versions = append(
-				providerMetadata.Rulesets[i].Versions,
-				metadata.Version{Version: supportedVersion, Latest: false},
-			)
-		}
-
-		// Mark the first version as latest as the versions are sorted from newest to oldest
-		if len(providerMetadata.Rulesets[i].Versions) > 0 {
-			providerMetadata.Rulesets[i].Versions[0].Latest = true
-		}
-	}
-
-	return providerMetadata
+	return registry.Default.ProviderOption(virtualgarden.ProviderID).MetadataFunc()
 }
@@ -31,14 +31,17 @@ const (
 // Provider is a Garden Cluster Provider that can
 // be used to implement rules against a garden cluster.
 type Provider struct {
-	id, name string
-	Config   *rest.Config
-	rulesets map[string]ruleset.Ruleset
-	metadata map[string]string
-	logger   sharedprovider.Logger
+	id, name       string
+	Config         *rest.Config
+	KubeconfigPath string
+	rulesets       map[string]ruleset.Ruleset
+	metadata       map[string]string
+	numWorkers     int
+	logger         sharedprovider.Logger
 }
 
-type providerArgs struct {
+// ConfigArgs are the arguments of the provider configuration.
+type ConfigArgs struct {
 	KubeconfigPath string `json:"kubeconfigPath" yaml:"kubeconfigPath"`
 }
 
@@ -47,7 +50,8 @@ var _ provider.Provider = &Provider{}
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
 	p := &Provider{
-		rulesets: make(map[string]ruleset.Ruleset),
+		rulesets:   make(map[string]ruleset.Ruleset),
+		numWorkers: 1,
 	}
 	for _, o := range options {
 		o(p)
@@ -66,8 +70,8 @@ func New(options ...CreateOption) (*Provider, error) {
 }
 
 // RunAll executes all Rulesets registered with the Provider.
-func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
-	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+func (p *Provider) RunAll(ctx context.Context, hooks ruleset.RunHooks) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, hooks, p.numWorkers, p.Logger())
 }
 
 func rulesetKey(rulesetID, rulesetVersion string) string {
@@ -80,12 +84,12 @@ func rulesetKey(rulesetID, rulesetVersion string) string {
 }
 
 // RunRuleset executes all Rules of a known Ruleset.
-func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
 	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
 	if !ok {
 		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
-	return rs.Run(ctx)
+	return rs.Run(ctx, hooks)
 }
 
 // RunRule executes specific Rule of a known Ruleset.
@@ -130,12 +134,16 @@ func (p *Provider) Metadata() map[string]string {
 
 // FromGenericConfig creates a Provider from ProviderConfig.
 func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
+	if providerConf.NumWorkers < 0 {
+		return nil, errors.New("provider numWorkers should not be a negative number")
+	}
+
 	providerArgsByte, err := json.Marshal(providerConf.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	var providerArgs providerArgs
+	var providerArgs ConfigArgs
 	if err := json.Unmarshal(providerArgsByte, &providerArgs); err != nil {
 		return nil, err
 	}
@@ -149,12 +157,18 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		WithID(providerConf.ID),
 		WithName(providerConf.Name),
 		WithConfig(kubeconfig),
+		WithKubeconfigPath(providerArgs.KubeconfigPath),
 		WithMetadata(providerConf.Metadata),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if providerConf.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(providerConf.NumWorkers)
+		setNumWorkers(provider)
+	}
+
 	return provider, nil
 }
 
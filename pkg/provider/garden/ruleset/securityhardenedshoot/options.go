@@ -6,6 +6,7 @@ package securityhardenedshoot
 
 import (
 	"log/slog"
+	"time"
 
 	"k8s.io/client-go/rest"
 )
@@ -53,9 +54,31 @@ func WithNumberOfWorkers(numWorkers int) CreateOption {
 	}
 }
 
+// WithTimeout sets the deadline of the whole run of a [Ruleset].
+func WithTimeout(timeout time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.timeout = timeout
+	}
+}
+
+// WithRuleTimeouts sets the deadlines of single rule runs of a [Ruleset] by rule id.
+func WithRuleTimeouts(ruleTimeouts map[string]time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
 // WithLogger the logger of a [Ruleset].
 func WithLogger(logger *slog.Logger) CreateOption {
 	return func(r *Ruleset) {
 		r.logger = logger
 	}
 }
+
+// WithInspectionOnly creates the rules of a [Ruleset] without the clients of the cluster.
+// Such rules can only be inspected, but must not be run.
+func WithInspectionOnly() CreateOption {
+	return func(r *Ruleset) {
+		r.inspectionOnly = true
+	}
+}
@@ -5,12 +5,19 @@
 package securityhardenedshoot
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
+	gardenerk8s "github.com/gardener/gardener/pkg/client/kubernetes"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/rule"
@@ -35,12 +42,15 @@ var (
 
 // Ruleset implements Security Hardened Shoot Cluster.
 type Ruleset struct {
-	version    string
-	rules      map[string]rule.Rule
-	Config     *rest.Config
-	numWorkers int
-	args       Args
-	logger     *slog.Logger
+	version        string
+	rules          map[string]rule.Rule
+	Config         *rest.Config
+	numWorkers     int
+	timeout        time.Duration
+	ruleTimeouts   map[string]time.Duration
+	args           Args
+	logger         *slog.Logger
+	inspectionOnly bool
 }
 
 // Args are Ruleset specific arguments.
@@ -78,8 +88,30 @@ func (r *Ruleset) Version() string {
 	return r.version
 }
 
-// FromGenericConfig creates a Ruleset from a RulesetConfig
-func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, logger provider.Logger) (*Ruleset, error) {
+// RuleArgs returns zero values of the argument types accepted by the rules of the given
+// ruleset version by rule ID. Rules that do not accept arguments are not included.
+func RuleArgs(version string) map[string]any {
+	switch version {
+	case "v0.1.0":
+		return maps.Clone(v01RuleArgs)
+	case "v0.2.0", "v0.2.1":
+		return maps.Clone(v02RuleArgs)
+	default:
+		return nil
+	}
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig.
+// The given options are applied before the rules are registered.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, logger provider.Logger, options ...CreateOption) (*Ruleset, error) {
+	if rulesetConfig.NumWorkers < 0 {
+		return nil, errors.New("ruleset numWorkers should not be a negative number")
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		return nil, errors.New("ruleset timeout should not be a negative duration")
+	}
+
 	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
 	if err != nil {
 		return nil, err
@@ -90,24 +122,49 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 		return nil, err
 	}
 
-	ruleset, err := New(
+	if len(rulesetArgs.ProjectNamespace) == 0 {
+		return nil, errors.New("ruleset args projectNamespace should not be empty")
+	}
+
+	if len(rulesetArgs.ShootName) == 0 {
+		return nil, errors.New("ruleset args shootName should not be empty")
+	}
+
+	ruleset, err := New(append([]CreateOption{
 		WithVersion(rulesetConfig.Version),
+		WithTimeout(rulesetConfig.Timeout),
 		WithConfig(managedConfig),
 		WithArgs(rulesetArgs),
-	)
+	}, options...)...)
 	if err != nil {
 		return nil, err
 	}
 
+	if rulesetConfig.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(rulesetConfig.NumWorkers)
+		setNumWorkers(ruleset)
+	}
+
 	ruleOptions := map[string]config.RuleOptionsConfig{}
+	ruleTimeouts := map[string]time.Duration{}
 	for _, opt := range rulesetConfig.RuleOptions {
 		if _, ok := ruleOptions[opt.RuleID]; ok {
 			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
 		}
 
+		if opt.Timeout < 0 {
+			return nil, fmt.Errorf("rule option for rule id: %s has a negative timeout", opt.RuleID)
+		}
+
 		ruleOptions[opt.RuleID] = opt
+		if opt.Timeout > 0 {
+			ruleTimeouts[opt.RuleID] = opt.Timeout
+		}
 	}
 
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v0.1.0":
 		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
@@ -133,6 +190,18 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 	return ruleset, nil
 }
 
+// getClient creates a client from the Config of the Ruleset.
+// It returns a nil client if the Ruleset is created for inspection only.
+func (r *Ruleset) getClient() (client.Client, error) {
+	if r.inspectionOnly {
+		return nil, nil
+	}
+	if r.Config == nil {
+		return nil, errors.New("cluster config is nil")
+	}
+	return client.New(r.Config, client.Options{Scheme: gardenerk8s.GardenScheme})
+}
+
 // RunRule executes specific known Rule of the Ruleset.
 func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
 	rr, ok := r.rules[id]
@@ -144,8 +213,24 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 }
 
 // Run executes all known Rules of the Ruleset.
-func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+func (r *Ruleset) Run(ctx context.Context, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(
+		ctx,
+		r,
+		r.rules,
+		hooks,
+		r.numWorkers,
+		r.Logger(),
+		sharedruleset.WithTimeout(r.timeout),
+		sharedruleset.WithRuleTimeouts(r.ruleTimeouts),
+	)
+}
+
+// Rules returns the Rules of the Ruleset sorted by their ids.
+func (r *Ruleset) Rules() []rule.Rule {
+	return slices.SortedFunc(maps.Values(r.rules), func(a, b rule.Rule) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
 }
 
 // AddRules adds Rules to the Ruleset.
@@ -8,9 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	gardenerk8s "github.com/gardener/gardener/pkg/client/kubernetes"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot/rules"
 	"github.com/gardener/diki/pkg/rule"
@@ -18,9 +15,7 @@ import (
 )
 
 func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	c, err := client.New(r.Config, client.Options{
-		Scheme: gardenerk8s.GardenScheme,
-	})
+	c, err := r.getClient()
 	if err != nil {
 		return err
 	}
@@ -97,7 +92,7 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithException(opt.Skip.Exception))
 		}
 	}
 
@@ -136,3 +131,9 @@ func getV01OptionOrNil[O rules.RuleOption](options any) (*O, error) {
 	}
 	return parseV01Options[O](options)
 }
+
+// v01RuleArgs contains zero values of the argument types accepted by the v01 rules.
+var v01RuleArgs = map[string]any{
+	"1000": rules.Options1000{},
+	"2007": rules.Options2007{},
+}
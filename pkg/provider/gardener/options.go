@@ -63,6 +63,16 @@ func WithMetadata(metadata map[string]string) CreateOption {
 	}
 }
 
+// WithNumberOfWorkers sets the max number of Workers of a [Provider].
+func WithNumberOfWorkers(numWorkers int) CreateOption {
+	return func(p *Provider) {
+		if numWorkers <= 0 {
+			panic("number of workers should be a positive number")
+		}
+		p.numWorkers = numWorkers
+	}
+}
+
 // WithLogger sets the logger of a Provider.
 func WithLogger(logger *slog.Logger) CreateOption {
 	return func(p *Provider) {
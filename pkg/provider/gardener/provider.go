@@ -37,10 +37,12 @@ type Provider struct {
 	Args                    Args
 	rulesets                map[string]ruleset.Ruleset
 	metadata                map[string]string
+	numWorkers              int
 	logger                  *slog.Logger
 }
 
-type providerArgs struct {
+// ConfigArgs are the arguments of the provider configuration.
+type ConfigArgs struct {
 	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
 	ShootKubeconfigPath    string            `json:"shootKubeconfigPath" yaml:"shootKubeconfigPath"`
 	SeedKubeconfigPath     string            `json:"seedKubeconfigPath" yaml:"seedKubeconfigPath"`
@@ -59,7 +61,8 @@ var _ provider.Provider = &Provider{}
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
 	p := &Provider{
-		rulesets: make(map[string]ruleset.Ruleset),
+		rulesets:   make(map[string]ruleset.Ruleset),
+		numWorkers: 1,
 	}
 	for _, o := range options {
 		o(p)
@@ -101,8 +104,8 @@ func validateAgrs(args Args) error {
 }
 
 // RunAll executes all Rulesets registered with the Provider.
-func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
-	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+func (p *Provider) RunAll(ctx context.Context, hooks ruleset.RunHooks) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, hooks, p.numWorkers, p.Logger())
 }
 
 func rulesetKey(rulesetID, rulesetVersion string) string {
@@ -110,12 +113,12 @@ func rulesetKey(rulesetID, rulesetVersion string) string {
 }
 
 // RunRuleset executes all Rules of a known Ruleset.
-func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
 	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
 	if !ok {
 		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
-	return rs.Run(ctx)
+	return rs.Run(ctx, hooks)
 }
 
 // RunRule executes specific Rule of a known Ruleset.
@@ -160,12 +163,16 @@ func (p *Provider) Metadata() map[string]string {
 
 // FromGenericConfig creates a Provider from ProviderConfig.
 func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
+	if providerConf.NumWorkers < 0 {
+		return nil, errors.New("provider numWorkers should not be a negative number")
+	}
+
 	providerArgsByte, err := json.Marshal(providerConf.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	var providerGardenerArgs providerArgs
+	var providerGardenerArgs ConfigArgs
 	if err := json.Unmarshal(providerArgsByte, &providerGardenerArgs); err != nil {
 		return nil, err
 	}
@@ -198,6 +205,11 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
+	if providerConf.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(providerConf.NumWorkers)
+		setNumWorkers(gardenerProvider)
+	}
+
 	return gardenerProvider, nil
 }
 
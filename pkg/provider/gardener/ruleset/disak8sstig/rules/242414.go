@@ -101,9 +101,9 @@ func (r *Rule242414) checkPods(pods []corev1.Pod, repliaceSets []appsv1.ReplicaS
 			for _, port := range container.Ports {
 				if port.HostPort != 0 && port.HostPort < 1024 {
 					containertTarget := target.With("container", container.Name, "details", fmt.Sprintf("port: %d", port.HostPort))
-					if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, port.HostPort); accepted {
+					if accepted, justification, exception := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, port.HostPort); accepted {
 						msg := cmp.Or(justification, "Pod accepted to have containers using hostPort < 1024.")
-						podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, containertTarget))
+						podCheckResults = append(podCheckResults, exception.AcceptedCheckResult(msg, containertTarget))
 					} else {
 						podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod has container using hostPort < 1024.", containertTarget))
 					}
@@ -118,9 +118,9 @@ func (r *Rule242414) checkPods(pods []corev1.Pod, repliaceSets []appsv1.ReplicaS
 	return checkResults
 }
 
-func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, hostPort int32) (bool, string) {
+func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, hostPort int32) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
@@ -128,11 +128,11 @@ func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, host
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
 			for _, acceptedHostPort := range acceptedPod.Ports {
 				if acceptedHostPort == hostPort {
-					return true, acceptedPod.Justification
+					return true, acceptedPod.Justification, acceptedPod.Exception
 				}
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
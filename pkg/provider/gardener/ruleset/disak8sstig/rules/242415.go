@@ -85,9 +85,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, namespaces map[string]corev1.N
 			for _, env := range container.Env {
 				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
 					containerTarget := target.With("container", container.Name, "details", fmt.Sprintf("variableName: %s, keyRef: %s", env.Name, env.ValueFrom.SecretKeyRef.Key))
-					if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
+					if accepted, justification, exception := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
 						msg := cmp.Or(justification, "Pod accepted to use environment to inject secret.")
-						podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, containerTarget))
+						podCheckResults = append(podCheckResults, exception.AcceptedCheckResult(msg, containerTarget))
 					} else {
 						podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod uses environment to inject secret.", containerTarget))
 					}
@@ -102,9 +102,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, namespaces map[string]corev1.N
 	return checkResults
 }
 
-func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string) {
+func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
@@ -112,11 +112,11 @@ func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, envi
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
 			for _, acceptedEnvironmentVariable := range acceptedPod.EnvironmentVariables {
 				if acceptedEnvironmentVariable == environmentVariable {
-					return true, acceptedPod.Justification
+					return true, acceptedPod.Justification, acceptedPod.Exception
 				}
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
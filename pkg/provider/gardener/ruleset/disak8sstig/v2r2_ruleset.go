@@ -6,11 +6,13 @@ package disak8sstig
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	kubernetesgardener "github.com/gardener/gardener/pkg/client/kubernetes"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
@@ -51,14 +53,30 @@ func getV2R2OptionOrNil[O rules.RuleOption](options any) (*O, error) {
 }
 
 func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	shootClient, err := client.New(r.ShootConfig, client.Options{Scheme: kubernetesgardener.ShootScheme})
-	if err != nil {
-		return err
-	}
+	var (
+		shootClient, seedClient client.Client
+		shootV1RESTClient       rest.Interface
+		err                     error
+	)
+	// rules created for inspection only do not get the clients of the clusters
+	if !r.inspectionOnly {
+		if r.ShootConfig == nil || r.SeedConfig == nil {
+			return errors.New("shoot and seed cluster configs should not be nil")
+		}
 
-	seedClient, err := client.New(r.SeedConfig, client.Options{Scheme: kubernetesgardener.SeedScheme})
-	if err != nil {
-		return err
+		if shootClient, err = client.New(r.ShootConfig, client.Options{Scheme: kubernetesgardener.ShootScheme}); err != nil {
+			return err
+		}
+
+		if seedClient, err = client.New(r.SeedConfig, client.Options{Scheme: kubernetesgardener.SeedScheme}); err != nil {
+			return err
+		}
+
+		shootClientSet, err := kubernetes.NewForConfig(r.ShootConfig)
+		if err != nil {
+			return err
+		}
+		shootV1RESTClient = shootClientSet.CoreV1().RESTClient()
 	}
 
 	shootPodContext, err := pod.NewSimplePodContext(shootClient, r.ShootConfig, r.AdditionalOpsPodLabels)
@@ -71,11 +89,6 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		return err
 	}
 
-	shootClientSet, err := kubernetes.NewForConfig(r.ShootConfig)
-	if err != nil {
-		return err
-	}
-
 	opts242400, err := getV2R2OptionOrNil[option.KubeProxyOptions](ruleOptions[sharedrules.ID242400].Args)
 	if err != nil {
 		return fmt.Errorf("rule option 242400 error: %s", err.Error())
@@ -164,18 +177,18 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		&sharedrules.Rule242386{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242387{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242388{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242389{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242390{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242391{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242392{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242393)),
@@ -215,7 +228,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242397{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID242398,
@@ -241,7 +254,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 				ControlPlaneClient:    seedClient,
 				ClusterClient:         shootClient,
 				ClusterPodContext:     shootPodContext,
-				ClusterV1RESTClient:   shootClientSet.CoreV1().RESTClient(),
+				ClusterV1RESTClient:   shootV1RESTClient,
 				ControlPlaneNamespace: r.shootNamespace,
 				Options:               opts242400,
 			}),
@@ -371,18 +384,18 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		&sharedrules.Rule242419{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242420{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242421{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242422{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242423{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242424{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242425{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242426{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242427{Client: seedClient, Namespace: r.shootNamespace},
@@ -394,7 +407,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		&sharedrules.Rule242433{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule242434{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule242436{Client: seedClient, Namespace: r.shootNamespace},
 		rule.NewSkipRule(
@@ -639,7 +652,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule245541{
 			Client:       shootClient,
-			V1RESTClient: shootClientSet.CoreV1().RESTClient(),
+			V1RESTClient: shootV1RESTClient,
 		},
 		&sharedrules.Rule245542{Client: seedClient, Namespace: r.shootNamespace},
 		&sharedrules.Rule245543{Client: seedClient, Namespace: r.shootNamespace, Options: opts245543},
@@ -665,7 +678,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithException(opt.Skip.Exception))
 		}
 	}
 
@@ -677,3 +690,17 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 
 	return r.AddRules(rules...)
 }
+
+// v2r2RuleArgs contains zero values of the argument types accepted by the v2r2 rules.
+var v2r2RuleArgs = map[string]any{
+	sharedrules.ID242400: option.KubeProxyOptions{},
+	sharedrules.ID242414: option.Options242414{},
+	sharedrules.ID242415: option.Options242415{},
+	sharedrules.ID242445: option.FileOwnerOptions{},
+	sharedrules.ID242446: option.FileOwnerOptions{},
+	sharedrules.ID242451: rules.Options242451{},
+	sharedrules.ID242466: option.KubeProxyOptions{},
+	sharedrules.ID242467: option.KubeProxyOptions{},
+	sharedrules.ID245543: sharedrules.Options245543{},
+	sharedrules.ID254800: sharedrules.Options254800{},
+}
@@ -42,6 +42,13 @@ func WithConfig(config *rest.Config) CreateOption {
 	}
 }
 
+// WithKubeconfigPath sets the path to the kubeconfig of a [Provider].
+func WithKubeconfigPath(kubeconfigPath string) CreateOption {
+	return func(p *Provider) {
+		p.KubeconfigPath = kubeconfigPath
+	}
+}
+
 // WithMetadata sets the metadata of a [Provider].
 func WithMetadata(metadata map[string]string) CreateOption {
 	return func(p *Provider) {
@@ -49,6 +56,16 @@ func WithMetadata(metadata map[string]string) CreateOption {
 	}
 }
 
+// WithNumberOfWorkers sets the max number of Workers of a [Provider].
+func WithNumberOfWorkers(numWorkers int) CreateOption {
+	return func(p *Provider) {
+		if numWorkers <= 0 {
+			panic("number of workers should be a positive number")
+		}
+		p.numWorkers = numWorkers
+	}
+}
+
 // WithLogger sets the logger of a [Provider].
 func WithLogger(logger provider.Logger) CreateOption {
 	return func(p *Provider) {
@@ -6,8 +6,10 @@ package disak8sstig
 
 import (
 	"log/slog"
+	"time"
 
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // CreateOption is a function that acts on a [Ruleset]
@@ -49,6 +51,23 @@ func WithArgs(args Args) CreateOption {
 	}
 }
 
+// WithClient sets the Client of a [Ruleset] that is used
+// by its rules instead of a client created from the Config.
+func WithClient(c client.Client) CreateOption {
+	return func(r *Ruleset) {
+		r.Client = c
+	}
+}
+
+// WithSupportedRules sets the ids of the rules of a [Ruleset] that can be run.
+// All other rules are reported as skipped with the given justification.
+func WithSupportedRules(ruleIDs []string, justification string) CreateOption {
+	return func(r *Ruleset) {
+		r.supportedRuleIDs = ruleIDs
+		r.unsupportedJustification = justification
+	}
+}
+
 // WithNumberOfWorkers sets the max number of Workers of a [Ruleset].
 func WithNumberOfWorkers(numWorkers int) CreateOption {
 	return func(r *Ruleset) {
@@ -59,9 +78,31 @@ func WithNumberOfWorkers(numWorkers int) CreateOption {
 	}
 }
 
+// WithTimeout sets the deadline of the whole run of a [Ruleset].
+func WithTimeout(timeout time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.timeout = timeout
+	}
+}
+
+// WithRuleTimeouts sets the deadlines of single rule runs of a [Ruleset] by rule id.
+func WithRuleTimeouts(ruleTimeouts map[string]time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
 // WithLogger the logger of a [Ruleset].
 func WithLogger(logger *slog.Logger) CreateOption {
 	return func(r *Ruleset) {
 		r.logger = logger
 	}
 }
+
+// WithInspectionOnly creates the rules of a [Ruleset] without the clients of the cluster.
+// Such rules can only be inspected, but must not be run.
+func WithInspectionOnly() CreateOption {
+	return func(r *Ruleset) {
+		r.inspectionOnly = true
+	}
+}
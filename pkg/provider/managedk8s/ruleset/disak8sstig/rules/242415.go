@@ -73,9 +73,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, namespaces map[string]corev1.N
 			for _, env := range container.Env {
 				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
 					target = target.With("container", container.Name, "details", fmt.Sprintf("variableName: %s, keyRef: %s", env.Name, env.ValueFrom.SecretKeyRef.Key))
-					if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
+					if accepted, justification, exception := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
 						msg := cmp.Or(justification, "Pod accepted to use environment to inject secret.")
-						podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, target))
+						podCheckResults = append(podCheckResults, exception.AcceptedCheckResult(msg, target))
 					} else {
 						podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod uses environment to inject secret.", target))
 					}
@@ -90,9 +90,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, namespaces map[string]corev1.N
 	return checkResults
 }
 
-func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string) {
+func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
@@ -100,11 +100,11 @@ func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, envi
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
 			for _, acceptedEnvironmentVariable := range acceptedPod.EnvironmentVariables {
 				if acceptedEnvironmentVariable == environmentVariable {
-					return true, acceptedPod.Justification
+					return true, acceptedPod.Justification, acceptedPod.Exception
 				}
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
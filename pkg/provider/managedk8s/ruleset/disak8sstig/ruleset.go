@@ -5,14 +5,20 @@
 package disak8sstig
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/rule"
@@ -40,10 +46,17 @@ type Ruleset struct {
 	rules                  map[string]rule.Rule
 	AdditionalOpsPodLabels map[string]string
 	Config                 *rest.Config
+	Client                 client.Client
 	numWorkers             int
+	timeout                time.Duration
+	ruleTimeouts           map[string]time.Duration
 	args                   Args
 	instanceID             string
 	logger                 *slog.Logger
+
+	supportedRuleIDs         []string
+	unsupportedJustification string
+	inspectionOnly           bool
 }
 
 // Args are Ruleset specific arguments.
@@ -84,8 +97,30 @@ func (r *Ruleset) Version() string {
 	return r.version
 }
 
-// FromGenericConfig creates a Ruleset from a RulesetConfig
-func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabels map[string]string, managedConfig *rest.Config) (*Ruleset, error) {
+// RuleArgs returns zero values of the argument types accepted by the rules of the given
+// ruleset version by rule ID. Rules that do not accept arguments are not included.
+func RuleArgs(version string) map[string]any {
+	switch version {
+	case "v2r2":
+		return maps.Clone(v2r2RuleArgs)
+	case "v2r3":
+		return maps.Clone(v2r3RuleArgs)
+	default:
+		return nil
+	}
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig.
+// The given options are applied before the rules are registered.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabels map[string]string, managedConfig *rest.Config, options ...CreateOption) (*Ruleset, error) {
+	if rulesetConfig.NumWorkers < 0 {
+		return nil, errors.New("ruleset numWorkers should not be a negative number")
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		return nil, errors.New("ruleset timeout should not be a negative duration")
+	}
+
 	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
 	if err != nil {
 		return nil, err
@@ -96,25 +131,42 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabel
 		return nil, err
 	}
 
-	ruleset, err := New(
+	ruleset, err := New(append([]CreateOption{
 		WithVersion(rulesetConfig.Version),
+		WithTimeout(rulesetConfig.Timeout),
 		WithAdditionalOpsPodLabels(additionalOpsPodLabels),
 		WithConfig(managedConfig),
 		WithArgs(rulesetArgs),
-	)
+	}, options...)...)
 	if err != nil {
 		return nil, err
 	}
 
+	if rulesetConfig.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(rulesetConfig.NumWorkers)
+		setNumWorkers(ruleset)
+	}
+
 	ruleOptions := map[string]config.RuleOptionsConfig{}
+	ruleTimeouts := map[string]time.Duration{}
 	for _, opt := range rulesetConfig.RuleOptions {
 		if _, ok := ruleOptions[opt.RuleID]; ok {
 			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
 		}
 
+		if opt.Timeout < 0 {
+			return nil, fmt.Errorf("rule option for rule id: %s has a negative timeout", opt.RuleID)
+		}
+
 		ruleOptions[opt.RuleID] = opt
+		if opt.Timeout > 0 {
+			ruleTimeouts[opt.RuleID] = opt.Timeout
+		}
 	}
 
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v2r2":
 		if err := ruleset.registerV2R2Rules(ruleOptions); err != nil {
@@ -131,6 +183,30 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, additionalOpsPodLabel
 	return ruleset, nil
 }
 
+// getClient returns the Client of the Ruleset or creates one from its Config if it is not set.
+// It returns a nil client if neither is set and the Ruleset is created for inspection only.
+func (r *Ruleset) getClient() (client.Client, error) {
+	if r.Client != nil {
+		return r.Client, nil
+	}
+	if r.inspectionOnly {
+		return nil, nil
+	}
+	if r.Config == nil {
+		return nil, errors.New("cluster config is nil")
+	}
+	return client.New(r.Config, client.Options{})
+}
+
+// skipUnsupportedRules replaces the rules that are not supported with skip rules.
+// All rules are supported if the supported rules are not set.
+func (r *Ruleset) skipUnsupportedRules(rules []rule.Rule) []rule.Rule {
+	if r.supportedRuleIDs == nil {
+		return rules
+	}
+	return sharedruleset.SkipRulesExcept(rules, r.supportedRuleIDs, r.unsupportedJustification)
+}
+
 // RunRule executes specific known Rule of the Ruleset.
 func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
 	rr, ok := r.rules[id]
@@ -142,8 +218,24 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 }
 
 // Run executes all known Rules of the Ruleset.
-func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+func (r *Ruleset) Run(ctx context.Context, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(
+		ctx,
+		r,
+		r.rules,
+		hooks,
+		r.numWorkers,
+		r.Logger(),
+		sharedruleset.WithTimeout(r.timeout),
+		sharedruleset.WithRuleTimeouts(r.ruleTimeouts),
+	)
+}
+
+// Rules returns the Rules of the Ruleset sorted by their ids.
+func (r *Ruleset) Rules() []rule.Rule {
+	return slices.SortedFunc(maps.Values(r.rules), func(a, b rule.Rule) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
 }
 
 // AddRules adds Rules to the Ruleset.
@@ -12,7 +12,7 @@ import (
 	"net/http"
 
 	"k8s.io/client-go/kubernetes"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
@@ -25,7 +25,7 @@ import (
 )
 
 func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	client, err := client.New(r.Config, client.Options{})
+	client, err := r.getClient()
 	if err != nil {
 		return err
 	}
@@ -35,15 +35,23 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		return err
 	}
 
-	clientSet, err := kubernetes.NewForConfig(r.Config)
-	if err != nil {
-		return err
-	}
+	var (
+		v1RESTClient      rest.Interface
+		kapiExternalURL   string
+		authorityCertPool = x509.NewCertPool()
+	)
+	// the config is not set when the rules are created for inspection only or with an injected client
+	if r.Config != nil {
+		clientSet, err := kubernetes.NewForConfig(r.Config)
+		if err != nil {
+			return err
+		}
+		v1RESTClient = clientSet.CoreV1().RESTClient()
+		kapiExternalURL = r.Config.Host
 
-	authorityCertPool := x509.NewCertPool()
-	ok := authorityCertPool.AppendCertsFromPEM(r.Config.CAData)
-	if !ok {
-		return fmt.Errorf("failed to parse kube-apiserver CA data from config")
+		if ok := authorityCertPool.AppendCertsFromPEM(r.Config.CAData); !ok {
+			return fmt.Errorf("failed to parse kube-apiserver CA data from config")
+		}
 	}
 
 	opts242383, err := getV2R3OptionOrNil[sharedrules.Options242383](ruleOptions[sharedrules.ID242383].Args)
@@ -222,7 +230,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242387{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID242388,
@@ -239,7 +247,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 			rule.SkipRuleWithSeverity(rule.SeverityMedium),
 		),
 		&rules.Rule242390{
-			KAPIExternalURL: r.Config.Host,
+			KAPIExternalURL: kapiExternalURL,
 			Client: &http.Client{
 				Transport: &http.Transport{
 					// the TLS MinVersion warnings are ignored in order to avoid version conflicts
@@ -251,11 +259,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		},
 		&sharedrules.Rule242391{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		&sharedrules.Rule242392{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		retry.New(
 			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242393)),
@@ -296,7 +304,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242397{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			// feature-gates.DynamicAuditing removed in v1.19. ref https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates-removed/
@@ -321,7 +329,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 				InstanceID:   r.instanceID,
 				Client:       client,
 				PodContext:   podContext,
-				V1RESTClient: clientSet.CoreV1().RESTClient(),
+				V1RESTClient: v1RESTClient,
 				Options:      opts242400,
 			}),
 			retry.WithRetryCondition(rcFileChecks),
@@ -455,7 +463,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242420{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID242421,
@@ -480,11 +488,11 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242424{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		&sharedrules.Rule242425{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID242426,
@@ -544,7 +552,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule242434{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID242436,
@@ -787,7 +795,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		),
 		&sharedrules.Rule245541{
 			Client:       client,
-			V1RESTClient: clientSet.CoreV1().RESTClient(),
+			V1RESTClient: v1RESTClient,
 		},
 		rule.NewSkipRule(
 			sharedrules.ID245542,
@@ -837,7 +845,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithException(opt.Skip.Exception))
 		}
 	}
 
@@ -847,7 +855,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 		return fmt.Errorf("revision expects 91 registered rules, but got: %d", len(rules))
 	}
 
-	return r.AddRules(rules...)
+	return r.AddRules(r.skipUnsupportedRules(rules)...)
 }
 
 func parseV2R3Options[O rules.RuleOption](options any) (*O, error) {
@@ -876,3 +884,28 @@ func getV2R3OptionOrNil[O rules.RuleOption](options any) (*O, error) {
 	}
 	return parseV2R3Options[O](options)
 }
+
+// v2r3RuleArgs contains zero values of the argument types accepted by the v2r3 rules.
+var v2r3RuleArgs = map[string]any{
+	sharedrules.ID242383: sharedrules.Options242383{},
+	sharedrules.ID242393: sharedrules.Options242393{},
+	sharedrules.ID242394: sharedrules.Options242394{},
+	sharedrules.ID242396: sharedrules.Options242396{},
+	sharedrules.ID242400: rules.Options242400{},
+	sharedrules.ID242404: sharedrules.Options242404{},
+	sharedrules.ID242406: sharedrules.Options242406{},
+	sharedrules.ID242407: sharedrules.Options242407{},
+	sharedrules.ID242414: option.Options242414{},
+	sharedrules.ID242415: option.Options242415{},
+	sharedrules.ID242417: sharedrules.Options242417{},
+	sharedrules.ID242442: rules.Options242442{},
+	sharedrules.ID242447: sharedrules.Options242447{},
+	sharedrules.ID242448: sharedrules.Options242448{},
+	sharedrules.ID242449: sharedrules.Options242449{},
+	sharedrules.ID242450: sharedrules.Options242450{},
+	sharedrules.ID242451: rules.Options242451{},
+	sharedrules.ID242452: sharedrules.Options242452{},
+	sharedrules.ID242453: sharedrules.Options242453{},
+	sharedrules.ID242466: rules.Options242466{},
+	sharedrules.ID242467: rules.Options242467{},
+}
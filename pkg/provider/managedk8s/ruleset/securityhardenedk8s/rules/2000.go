@@ -126,7 +126,7 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 		if deniesAllIngress && !allowsAllIngress {
 			checkResults = append(checkResults, rule.PassedCheckResult("Ingress traffic is denied by default.", deniesAllIngressTarget))
 		} else {
-			accepted, justification := r.acceptedIngress(namespace)
+			accepted, justification, exception := r.acceptedIngress(namespace)
 
 			acceptedTarget := target
 			msg := "Namespace is accepted to allow Ingress traffic by default."
@@ -140,7 +140,7 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 
 			switch {
 			case accepted:
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, acceptedTarget))
+				checkResults = append(checkResults, exception.AcceptedCheckResult(msg, acceptedTarget))
 			case allowsAllIngress:
 				checkResults = append(checkResults, rule.FailedCheckResult("All Ingress traffic is allowed by default.", allowsAllIngressTarget))
 			default:
@@ -151,7 +151,7 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 		if deniesAllEgress && !allowsAllEgress {
 			checkResults = append(checkResults, rule.PassedCheckResult("Egress traffic is denied by default.", deniesAllEgressTarget))
 		} else {
-			accepted, justification := r.acceptedEgress(namespace)
+			accepted, justification, exception := r.acceptedEgress(namespace)
 
 			acceptedTarget := target
 			msg := "Namespace is accepted to allow Egress traffic by default."
@@ -165,7 +165,7 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 
 			switch {
 			case accepted:
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, acceptedTarget))
+				checkResults = append(checkResults, exception.AcceptedCheckResult(msg, acceptedTarget))
 			case allowsAllEgress:
 				checkResults = append(checkResults, rule.FailedCheckResult("All Egress traffic is allowed by default.", allowsAllEgressTarget))
 			default:
@@ -177,33 +177,33 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2000) acceptedIngress(namespace corev1.Namespace) (bool, string) {
+func (r *Rule2000) acceptedIngress(namespace corev1.Namespace) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedNamespace := range r.Options.AcceptedNamespaces {
 
 		if utils.MatchLabels(namespace.Labels, acceptedNamespace.MatchLabels) &&
 			acceptedNamespace.AcceptedTraffic.Ingress {
-			return true, acceptedNamespace.Justification
+			return true, acceptedNamespace.Justification, acceptedNamespace.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
 
-func (r *Rule2000) acceptedEgress(namespace corev1.Namespace) (bool, string) {
+func (r *Rule2000) acceptedEgress(namespace corev1.Namespace) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedNamespace := range r.Options.AcceptedNamespaces {
 		if utils.MatchLabels(namespace.Labels, acceptedNamespace.MatchLabels) &&
 			acceptedNamespace.AcceptedTraffic.Egress {
-			return true, acceptedNamespace.Justification
+			return true, acceptedNamespace.Justification, acceptedNamespace.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
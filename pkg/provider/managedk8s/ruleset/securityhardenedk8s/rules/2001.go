@@ -42,8 +42,9 @@ type Options2001 struct {
 func (o Options2001) Validate() field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(p.Exception, field.NewPath("acceptedPods").Index(i))...)
 	}
 
 	return allErrs
@@ -118,9 +119,9 @@ func (r *Rule2001) Run(ctx context.Context) (rule.RuleResult, error) {
 			containerTarget := podTarget.With("container", container.Name)
 
 			if container.SecurityContext == nil || allowsPrivilegeEscalation(*container.SecurityContext) {
-				if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels); accepted {
+				if accepted, justification, exception := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels); accepted {
 					msg := cmp.Or(justification, "Pod accepted to escalate privileges.")
-					podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, containerTarget))
+					podCheckResults = append(podCheckResults, exception.AcceptedCheckResult(msg, containerTarget))
 				} else {
 					podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod must not escalate privileges.", containerTarget))
 				}
@@ -135,17 +136,17 @@ func (r *Rule2001) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2001) accepted(podLabels, namespaceLabels map[string]string) (bool, string) {
+func (r *Rule2001) accepted(podLabels, namespaceLabels map[string]string) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(podLabels, acceptedPod.MatchLabels) &&
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
-			return true, acceptedPod.Justification
+			return true, acceptedPod.Justification, acceptedPod.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
@@ -39,8 +39,9 @@ type Options2002 struct {
 func (o Options2002) Validate() field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, sc := range o.AcceptedStorageClasses {
+	for i, sc := range o.AcceptedStorageClasses {
 		allErrs = append(allErrs, sc.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(sc.Exception, field.NewPath("acceptedStorageClasses").Index(i))...)
 	}
 
 	return allErrs
@@ -78,9 +79,9 @@ func (r *Rule2002) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
-		if accepted, justification := r.accepted(storageClass.Labels); accepted {
+		if accepted, justification, exception := r.accepted(storageClass.Labels); accepted {
 			msg := cmp.Or(justification, "StorageClass accepted to not have Delete ReclaimPolicy.")
-			checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+			checkResults = append(checkResults, exception.AcceptedCheckResult(msg, target))
 		} else {
 			checkResults = append(checkResults, rule.FailedCheckResult("StorageClass does not have a Delete ReclaimPolicy set.", target))
 		}
@@ -89,16 +90,16 @@ func (r *Rule2002) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), err
 }
 
-func (r *Rule2002) accepted(storageClassLabels map[string]string) (bool, string) {
+func (r *Rule2002) accepted(storageClassLabels map[string]string) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedStorageClass := range r.Options.AcceptedStorageClasses {
 		if utils.MatchLabels(storageClassLabels, acceptedStorageClass.MatchLabels) {
-			return true, acceptedStorageClass.Justification
+			return true, acceptedStorageClass.Justification, acceptedStorageClass.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
@@ -42,8 +42,9 @@ func (o Options2003) Validate() field.ErrorList {
 		allErrs  field.ErrorList
 		rootPath = field.NewPath("acceptedPods")
 	)
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(p.Exception, rootPath.Index(i))...)
 		if len(p.VolumeNames) == 0 {
 			allErrs = append(allErrs, field.Required(rootPath.Child("volumeNames"), "must not be empty"))
 		}
@@ -115,9 +116,9 @@ func (r *Rule2003) Run(ctx context.Context) (rule.RuleResult, error) {
 				volume.Projected == nil &&
 				volume.Secret == nil {
 				uses = true
-				accepted, justification := r.accepted(volume, pod, allNamespaces[pod.Namespace])
+				accepted, justification, exception := r.accepted(volume, pod, allNamespaces[pod.Namespace])
 				if accepted {
-					checkResults = append(checkResults, rule.AcceptedCheckResult(justification, volumeTarget))
+					checkResults = append(checkResults, exception.AcceptedCheckResult(justification, volumeTarget))
 				} else {
 					checkResults = append(checkResults, rule.FailedCheckResult("Pod uses not allowed volume type.", volumeTarget))
 				}
@@ -131,18 +132,18 @@ func (r *Rule2003) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2003) accepted(volume corev1.Volume, pod corev1.Pod, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2003) accepted(volume corev1.Volume, pod corev1.Pod, namespace corev1.Namespace) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(pod.Labels, acceptedPod.MatchLabels) && utils.MatchLabels(namespace.Labels, acceptedPod.NamespaceMatchLabels) {
 			if slices.Contains(acceptedPod.VolumeNames, "*") || slices.Contains(acceptedPod.VolumeNames, volume.Name) {
-				return true, acceptedPod.Justification
+				return true, acceptedPod.Justification, acceptedPod.Exception
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
@@ -39,8 +39,9 @@ type Options2004 struct {
 func (o Options2004) Validate() field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, s := range o.AcceptedServices {
+	for i, s := range o.AcceptedServices {
 		allErrs = append(allErrs, s.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(s.Exception, field.NewPath("acceptedServices").Index(i))...)
 	}
 
 	return allErrs
@@ -79,9 +80,9 @@ func (r *Rule2004) Run(ctx context.Context) (rule.RuleResult, error) {
 		serviceTarget := rule.NewTarget("kind", "service", "name", service.Name, "namespace", service.Namespace)
 
 		if service.Spec.Type == corev1.ServiceTypeNodePort {
-			if accepted, justification := r.accepted(service, namespaces[service.Namespace]); accepted {
+			if accepted, justification, exception := r.accepted(service, namespaces[service.Namespace]); accepted {
 				msg := cmp.Or(justification, "Service accepted to be of type NodePort.")
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, serviceTarget))
+				checkResults = append(checkResults, exception.AcceptedCheckResult(msg, serviceTarget))
 			} else {
 				checkResults = append(checkResults, rule.FailedCheckResult("Service should not be of type NodePort.", serviceTarget))
 			}
@@ -93,17 +94,17 @@ func (r *Rule2004) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2004) accepted(service corev1.Service, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2004) accepted(service corev1.Service, namespace corev1.Namespace) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedService := range r.Options.AcceptedServices {
 		if utils.MatchLabels(service.Labels, acceptedService.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedService.NamespaceMatchLabels) {
-			return true, acceptedService.Justification
+			return true, acceptedService.Justification, acceptedService.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
@@ -42,12 +42,14 @@ type Options2006 struct {
 func (o Options2006) Validate() field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, r := range o.AcceptedRoles {
+	for i, r := range o.AcceptedRoles {
 		allErrs = append(allErrs, r.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(r.Exception, field.NewPath("acceptedRoles").Index(i))...)
 	}
 
-	for _, c := range o.AcceptedClusterRoles {
+	for i, c := range o.AcceptedClusterRoles {
 		allErrs = append(allErrs, c.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(c.Exception, field.NewPath("acceptedClusterRoles").Index(i))...)
 	}
 
 	return allErrs
@@ -87,14 +89,14 @@ func (r *Rule2006) Run(ctx context.Context) (rule.RuleResult, error) {
 
 	var (
 		checkResults []rule.CheckResult
-		checkRules   = func(policyRules []rbacv1.PolicyRule, accepted bool, justification string, target rule.Target) rule.CheckResult {
+		checkRules   = func(policyRules []rbacv1.PolicyRule, accepted bool, justification string, exception rule.Exception, target rule.Target) rule.CheckResult {
 			msg := cmp.Or(justification, "Role is accepted to use \"*\" in policy rule resources.")
 
 			for _, policyRule := range policyRules {
 				for _, resource := range policyRule.Resources {
 					if strings.Contains(resource, "*") {
 						if accepted {
-							return rule.AcceptedCheckResult(msg, target)
+							return exception.AcceptedCheckResult(msg, target)
 						}
 						return rule.FailedCheckResult("Role uses \"*\" in policy rule resources.", target)
 					}
@@ -107,45 +109,45 @@ func (r *Rule2006) Run(ctx context.Context) (rule.RuleResult, error) {
 	for _, role := range roles {
 		target := rule.NewTarget("kind", "role", "name", role.Name, "namespace", role.Namespace)
 
-		accepted, justification := r.acceptedRole(role, namespaces[role.Namespace])
-		checkResults = append(checkResults, checkRules(role.Rules, accepted, justification, target))
+		accepted, justification, exception := r.acceptedRole(role, namespaces[role.Namespace])
+		checkResults = append(checkResults, checkRules(role.Rules, accepted, justification, exception, target))
 	}
 
 	for _, clusterRole := range clusterRoles {
 		target := rule.NewTarget("kind", "clusterRole", "name", clusterRole.Name)
 
-		accepted, justification := r.acceptedClusterRole(clusterRole)
-		checkResults = append(checkResults, checkRules(clusterRole.Rules, accepted, justification, target))
+		accepted, justification, exception := r.acceptedClusterRole(clusterRole)
+		checkResults = append(checkResults, checkRules(clusterRole.Rules, accepted, justification, exception, target))
 	}
 
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2006) acceptedRole(role rbacv1.Role, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2006) acceptedRole(role rbacv1.Role, namespace corev1.Namespace) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedRole := range r.Options.AcceptedRoles {
 		if utils.MatchLabels(role.Labels, acceptedRole.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedRole.NamespaceMatchLabels) {
-			return true, acceptedRole.Justification
+			return true, acceptedRole.Justification, acceptedRole.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
 
-func (r *Rule2006) acceptedClusterRole(clusterRole rbacv1.ClusterRole) (bool, string) {
+func (r *Rule2006) acceptedClusterRole(clusterRole rbacv1.ClusterRole) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedClusterRoles := range r.Options.AcceptedClusterRoles {
 		if utils.MatchLabels(clusterRole.Labels, acceptedClusterRoles.MatchLabels) {
-			return true, acceptedClusterRoles.Justification
+			return true, acceptedClusterRoles.Justification, acceptedClusterRoles.Exception
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
@@ -39,6 +39,7 @@ type Options2008 struct {
 
 type AcceptedPods2008 struct {
 	option.NamespacedObjectSelector
+	rule.Exception
 	VolumeNames   []string `json:"volumeNames" yaml:"volumeNames"`
 	Justification string   `json:"justification" yaml:"justification"`
 }
@@ -49,8 +50,9 @@ func (o Options2008) Validate() field.ErrorList {
 		allErrs  field.ErrorList
 		rootPath = field.NewPath("acceptedPods")
 	)
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, disaoptions.ValidateException(p.Exception, rootPath.Index(i))...)
 		if len(p.VolumeNames) == 0 {
 			allErrs = append(allErrs, field.Required(rootPath.Child("volumeNames"), "must not be empty"))
 		}
@@ -111,9 +113,9 @@ func (r *Rule2008) Run(ctx context.Context) (rule.RuleResult, error) {
 			volumeTarget := podTarget.With("volume", volume.Name)
 			if volume.HostPath != nil {
 				uses = true
-				if accepted, justification := r.accepted(pod, namespaces[pod.Namespace], volume.Name); accepted {
+				if accepted, justification, exception := r.accepted(pod, namespaces[pod.Namespace], volume.Name); accepted {
 					msg := cmp.Or(justification, "Pod accepted to use volume of type hostPath.")
-					checkResults = append(checkResults, rule.AcceptedCheckResult(msg, volumeTarget))
+					checkResults = append(checkResults, exception.AcceptedCheckResult(msg, volumeTarget))
 				} else {
 					checkResults = append(checkResults, rule.FailedCheckResult("Pod must not use volumes of type hostPath.", volumeTarget))
 				}
@@ -126,19 +128,19 @@ func (r *Rule2008) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2008) accepted(pod corev1.Pod, namespace corev1.Namespace, volumeName string) (bool, string) {
+func (r *Rule2008) accepted(pod corev1.Pod, namespace corev1.Namespace, volumeName string) (bool, string, rule.Exception) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", rule.Exception{}
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(pod.Labels, acceptedPod.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedPod.NamespaceMatchLabels) {
 			if slices.Contains(acceptedPod.VolumeNames, "*") || slices.Contains(acceptedPod.VolumeNames, volumeName) {
-				return true, acceptedPod.Justification
+				return true, acceptedPod.Justification, acceptedPod.Exception
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", rule.Exception{}
 }
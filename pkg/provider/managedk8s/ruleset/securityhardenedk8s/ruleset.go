@@ -5,11 +5,17 @@
 package securityhardenedk8s
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/rule"
@@ -33,11 +39,18 @@ var (
 
 // Ruleset implements Security Hardened Kubernetes Cluster.
 type Ruleset struct {
-	version    string
-	rules      map[string]rule.Rule
-	Config     *rest.Config
-	numWorkers int
-	logger     *slog.Logger
+	version      string
+	rules        map[string]rule.Rule
+	Config       *rest.Config
+	Client       client.Client
+	numWorkers   int
+	timeout      time.Duration
+	ruleTimeouts map[string]time.Duration
+	logger       *slog.Logger
+
+	supportedRuleIDs         []string
+	unsupportedJustification string
+	inspectionOnly           bool
 }
 
 // New creates a new Ruleset.
@@ -69,25 +82,62 @@ func (r *Ruleset) Version() string {
 	return r.version
 }
 
-// FromGenericConfig creates a Ruleset from a RulesetConfig
-func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config) (*Ruleset, error) {
-	ruleset, err := New(
+// RuleArgs returns zero values of the argument types accepted by the rules of the given
+// ruleset version by rule ID. Rules that do not accept arguments are not included.
+func RuleArgs(version string) map[string]any {
+	switch version {
+	case "v0.1.0":
+		return maps.Clone(v01RuleArgs)
+	default:
+		return nil
+	}
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig.
+// The given options are applied before the rules are registered.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, options ...CreateOption) (*Ruleset, error) {
+	if rulesetConfig.NumWorkers < 0 {
+		return nil, errors.New("ruleset numWorkers should not be a negative number")
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		return nil, errors.New("ruleset timeout should not be a negative duration")
+	}
+
+	ruleset, err := New(append([]CreateOption{
 		WithVersion(rulesetConfig.Version),
+		WithTimeout(rulesetConfig.Timeout),
 		WithConfig(managedConfig),
-	)
+	}, options...)...)
 	if err != nil {
 		return nil, err
 	}
 
+	if rulesetConfig.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(rulesetConfig.NumWorkers)
+		setNumWorkers(ruleset)
+	}
+
 	ruleOptions := map[string]config.RuleOptionsConfig{}
+	ruleTimeouts := map[string]time.Duration{}
 	for _, opt := range rulesetConfig.RuleOptions {
 		if _, ok := ruleOptions[opt.RuleID]; ok {
 			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
 		}
 
+		if opt.Timeout < 0 {
+			return nil, fmt.Errorf("rule option for rule id: %s has a negative timeout", opt.RuleID)
+		}
+
 		ruleOptions[opt.RuleID] = opt
+		if opt.Timeout > 0 {
+			ruleTimeouts[opt.RuleID] = opt.Timeout
+		}
 	}
 
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
 	switch rulesetConfig.Version {
 	case "v0.1.0":
 		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
@@ -100,6 +150,30 @@ func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.C
 	return ruleset, nil
 }
 
+// getClient returns the Client of the Ruleset or creates one from its Config if it is not set.
+// It returns a nil client if neither is set and the Ruleset is created for inspection only.
+func (r *Ruleset) getClient() (client.Client, error) {
+	if r.Client != nil {
+		return r.Client, nil
+	}
+	if r.inspectionOnly {
+		return nil, nil
+	}
+	if r.Config == nil {
+		return nil, errors.New("cluster config is nil")
+	}
+	return client.New(r.Config, client.Options{})
+}
+
+// skipUnsupportedRules replaces the rules that are not supported with skip rules.
+// All rules are supported if the supported rules are not set.
+func (r *Ruleset) skipUnsupportedRules(rules []rule.Rule) []rule.Rule {
+	if r.supportedRuleIDs == nil {
+		return rules
+	}
+	return sharedruleset.SkipRulesExcept(rules, r.supportedRuleIDs, r.unsupportedJustification)
+}
+
 // RunRule executes specific known Rule of the Ruleset.
 func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
 	rr, ok := r.rules[id]
@@ -111,8 +185,24 @@ func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, erro
 }
 
 // Run executes all known Rules of the Ruleset.
-func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
-	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+func (r *Ruleset) Run(ctx context.Context, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(
+		ctx,
+		r,
+		r.rules,
+		hooks,
+		r.numWorkers,
+		r.Logger(),
+		sharedruleset.WithTimeout(r.timeout),
+		sharedruleset.WithRuleTimeouts(r.ruleTimeouts),
+	)
+}
+
+// Rules returns the Rules of the Ruleset sorted by their ids.
+func (r *Ruleset) Rules() []rule.Rule {
+	return slices.SortedFunc(maps.Values(r.rules), func(a, b rule.Rule) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
 }
 
 // AddRules adds Rules to the Ruleset.
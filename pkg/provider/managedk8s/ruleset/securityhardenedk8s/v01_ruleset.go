@@ -8,8 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s/rules"
 	"github.com/gardener/diki/pkg/rule"
@@ -17,7 +15,7 @@ import (
 )
 
 func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	c, err := client.New(r.Config, client.Options{})
+	c, err := r.getClient()
 	if err != nil {
 		return err
 	}
@@ -108,7 +106,7 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithException(opt.Skip.Exception))
 		}
 	}
 
@@ -118,7 +116,7 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 		return fmt.Errorf("revision expects 9 registered rules, but got: %d", len(rules))
 	}
 
-	return r.AddRules(rules...)
+	return r.AddRules(r.skipUnsupportedRules(rules)...)
 }
 
 func parseV01Options[O rules.RuleOption](options any) (*O, error) {
@@ -147,3 +145,16 @@ func getV01OptionOrNil[O rules.RuleOption](options any) (*O, error) {
 	}
 	return parseV01Options[O](options)
 }
+
+// v01RuleArgs contains zero values of the argument types accepted by the v01 rules.
+var v01RuleArgs = map[string]any{
+	"2000": rules.Options2000{},
+	"2001": rules.Options2001{},
+	"2002": rules.Options2002{},
+	"2003": rules.Options2003{},
+	"2004": rules.Options2004{},
+	"2005": rules.Options2005{},
+	"2006": rules.Options2006{},
+	"2007": rules.Options2007{},
+	"2008": rules.Options2008{},
+}
@@ -18,8 +18,12 @@ type Provider interface {
 	ID() string
 	Name() string
 	Metadata() map[string]string
-	RunAll(ctx context.Context) (ProviderResult, error)
-	RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error)
+	// RunAll runs all rulesets of the provider with the given hooks. It can return
+	// partial results together with a non-nil error.
+	RunAll(ctx context.Context, hooks ruleset.RunHooks) (ProviderResult, error)
+	// RunRuleset runs a single ruleset of the provider with the given hooks. It can return
+	// partial results together with a non-nil error.
+	RunRuleset(ctx context.Context, rulesetID, rulesetVersion string, hooks ruleset.RunHooks) (ruleset.RulesetResult, error)
 	RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error)
 }
 
@@ -37,8 +41,41 @@ type ProviderFromConfigFunc func(conf config.ProviderConfig) (Provider, error)
 // MetadataFunc constructs a detailed Provider metadata object.
 type MetadataFunc func() metadata.ProviderDetailed
 
+// RulesFromConfigFunc constructs the Rules of a Ruleset from RulesetConfig without connecting to any cluster.
+// The returned Rules can be inspected, but should not be run.
+type RulesFromConfigFunc func(conf config.RulesetConfig) ([]rule.Rule, error)
+
+// ArgsTypes contains zero values of the argument types accepted in the configuration of a provider.
+type ArgsTypes struct {
+	// Args is the type of the provider arguments.
+	Args any
+	// Rulesets contains the argument types of the rulesets by ruleset ID and version.
+	Rulesets map[string]map[string]RulesetArgsTypes
+}
+
+// RulesetArgsTypes contains values of the argument types accepted in the configuration of a ruleset version.
+type RulesetArgsTypes struct {
+	// Args is the zero value of the type of the ruleset arguments. It is nil if the ruleset does not accept arguments.
+	Args any
+	// InspectArgs are arguments that can be used with a [RulesFromConfigFunc] to inspect the ruleset.
+	// It is nil if the ruleset does not accept arguments.
+	InspectArgs any
+	// RuleArgs contains the types of the rule arguments by rule ID.
+	// Rules that do not accept arguments are not included.
+	RuleArgs map[string]any
+	// AnyRuleArgs is true if every rule accepts arguments of any object type. RuleArgs is ignored if it is set.
+	AnyRuleArgs bool
+}
+
+// ArgsTypesFunc returns the argument types accepted in the configuration of a provider.
+type ArgsTypesFunc func() ArgsTypes
+
 // ProviderOption constructs a pair of a configuarion and metadata function for a specific provider.
+// RulesFromConfigFunc is optional and is used to inspect the rulesets of the provider.
+// ArgsTypesFunc is optional and is used to describe the arguments accepted by the provider.
 type ProviderOption struct {
 	ProviderFromConfigFunc
 	MetadataFunc
+	RulesFromConfigFunc
+	ArgsTypesFunc
 }
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// RulesetProvider is a provider to which rulesets can be added.
+type RulesetProvider interface {
+	provider.Provider
+	AddRulesets(rulesets ...ruleset.Ruleset) error
+}
+
+// ProviderFactory creates a provider without rulesets from its configuration.
+// The passed logger should be set as the logger of the provider.
+type ProviderFactory func(conf config.ProviderConfig, logger *slog.Logger) (RulesetProvider, error)
+
+// OfflineProviderFactory creates a provider without cluster configs.
+// It is used to construct rulesets whose rules are only inspected, but never run.
+type OfflineProviderFactory func() (RulesetProvider, error)
+
+// RulesetFactory creates a ruleset of a provider from its configuration. The passed provider is
+// created by the factories of the registered provider. The passed logger is the logger of the provider.
+type RulesetFactory func(p provider.Provider, conf config.RulesetConfig, logger *slog.Logger) (ruleset.Ruleset, error)
+
+// Provider is the registration of a provider.
+type Provider struct {
+	metadata.Provider
+	// Args is the zero value of the type of the provider arguments.
+	Args any
+	// FromConfig creates the provider from its configuration.
+	FromConfig ProviderFactory
+	// Offline creates the provider without connecting to any cluster.
+	Offline OfflineProviderFactory
+}
+
+// Ruleset is the registration of a ruleset of a provider.
+// The versions of the ruleset metadata are sorted from newest to oldest.
+type Ruleset struct {
+	metadata.Ruleset
+	// Args is the zero value of the type of the ruleset arguments.
+	// It is nil if the ruleset does not accept arguments.
+	Args any
+	// InspectArgs are arguments used to inspect the ruleset without a configuration, e.g. placeholders
+	// for arguments that identify objects in a cluster. It is optional and defaults to Args.
+	InspectArgs any
+	// RuleArgs returns zero values of the argument types accepted by the rules of a ruleset version by rule ID.
+	// It is optional for rulesets whose rules do not accept arguments.
+	RuleArgs func(version string) map[string]any
+	// AnyRuleArgs is true if every rule of the ruleset accepts arguments of any object type,
+	// e.g. because the arguments are passed on as they are. RuleArgs is ignored if it is set.
+	AnyRuleArgs bool
+	// FromConfig creates the ruleset from its configuration.
+	FromConfig RulesetFactory
+	// Inspect creates the ruleset with a provider of the [OfflineProviderFactory] when its rules are
+	// only inspected. It is required for rulesets that need the clients of a cluster, since FromConfig
+	// must not create them without one. It replaces FromConfig in [Registry.RulesFromConfig].
+	Inspect RulesetFactory
+}
+
+// Registry contains the providers and rulesets supported by diki.
+// Rulesets can be registered before the provider they belong to.
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+	rulesets  map[string][]Ruleset
+}
+
+// Default is the registry used by the diki command and the builder functions.
+var Default = New()
+
+// New creates an empty Registry.
+func New() *Registry {
+	return &Registry{
+		providers: map[string]Provider{},
+		rulesets:  map[string][]Ruleset{},
+	}
+}
+
+// Versions returns the metadata of ruleset versions sorted from newest to oldest.
+// The first version is marked as latest.
+func Versions(versions []string) []metadata.Version {
+	metadataVersions := make([]metadata.Version, 0, len(versions))
+	for i, version := range versions {
+		metadataVersions = append(metadataVersions, metadata.Version{Version: version, Latest: i == 0})
+	}
+	return metadataVersions
+}
+
+// RegisterProvider registers a provider.
+func (r *Registry) RegisterProvider(p Provider) error {
+	if len(p.ID) == 0 {
+		return errors.New("provider id must not be empty")
+	}
+	if p.FromConfig == nil {
+		return fmt.Errorf("provider %s does not have a factory", p.ID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.providers[p.ID]; ok {
+		return fmt.Errorf("provider with id %s is already registered", p.ID)
+	}
+	r.providers[p.ID] = p
+	return nil
+}
+
+// RegisterRuleset registers a ruleset of a provider.
+func (r *Registry) RegisterRuleset(providerID string, rs Ruleset) error {
+	if len(rs.ID) == 0 {
+		return fmt.Errorf("ruleset id of provider %s must not be empty", providerID)
+	}
+	if rs.FromConfig == nil {
+		return fmt.Errorf("ruleset %s of provider %s does not have a factory", rs.ID, providerID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if slices.ContainsFunc(r.rulesets[providerID], func(registered Ruleset) bool { return registered.ID == rs.ID }) {
+		return fmt.Errorf("ruleset with id %s is already registered for provider %s", rs.ID, providerID)
+	}
+	r.rulesets[providerID] = append(r.rulesets[providerID], rs)
+	return nil
+}
+
+// MustRegisterProvider registers a provider and panics on error.
+func (r *Registry) MustRegisterProvider(p Provider) {
+	if err := r.RegisterProvider(p); err != nil {
+		panic(err)
+	}
+}
+
+// MustRegisterRuleset registers a ruleset of a provider and panics on error.
+func (r *Registry) MustRegisterRuleset(providerID string, rs Ruleset) {
+	if err := r.RegisterRuleset(providerID, rs); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterProvider registers a provider in the [Default] registry and panics on error.
+func RegisterProvider(p Provider) {
+	Default.MustRegisterProvider(p)
+}
+
+// RegisterRuleset registers a ruleset of a provider in the [Default] registry and panics on error.
+// It is meant to be called from the init function of packages that add rulesets to diki.
+func RegisterRuleset(providerID string, rs Ruleset) {
+	Default.MustRegisterRuleset(providerID, rs)
+}
+
+func (r *Registry) get(providerID string) (Provider, []Ruleset, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.providers[providerID]
+	if !ok {
+		return Provider{}, nil, fmt.Errorf("unknown provider identifier: %s", providerID)
+	}
+	return p, slices.Clone(r.rulesets[providerID]), nil
+}
+
+func findRuleset(rulesets []Ruleset, rulesetID string) (Ruleset, error) {
+	i := slices.IndexFunc(rulesets, func(rs Ruleset) bool { return rs.ID == rulesetID })
+	if i < 0 {
+		return Ruleset{}, fmt.Errorf("unknown ruleset identifier: %s", rulesetID)
+	}
+	return rulesets[i], nil
+}
+
+// ProviderFromConfig creates a registered provider with its rulesets from a [config.ProviderConfig].
+func (r *Registry) ProviderFromConfig(providerID string, conf config.ProviderConfig) (provider.Provider, error) {
+	registeredProvider, rulesets, err := r.get(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	providerLogger := slog.Default().With("provider", conf.ID)
+	p, err := registeredProvider.FromConfig(conf, providerLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	providerRulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
+	for _, rulesetConfig := range conf.Rulesets {
+		registeredRuleset, err := findRuleset(rulesets, rulesetConfig.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		rs, err := registeredRuleset.FromConfig(p, rulesetConfig, providerLogger)
+		if err != nil {
+			return nil, err
+		}
+		providerRulesets = append(providerRulesets, rs)
+	}
+
+	if err := p.AddRulesets(providerRulesets...); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// RulesFromConfig returns the Rules of a ruleset of a registered provider without connecting to a cluster.
+// The returned Rules can be inspected, but should not be run.
+func (r *Registry) RulesFromConfig(providerID string, conf config.RulesetConfig) ([]rule.Rule, error) {
+	registeredProvider, rulesets, err := r.get(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredRuleset, err := findRuleset(rulesets, conf.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if registeredProvider.Offline == nil {
+		return nil, fmt.Errorf("provider %s does not support inspecting rulesets", providerID)
+	}
+
+	p, err := registeredProvider.Offline()
+	if err != nil {
+		return nil, err
+	}
+
+	rulesetFactory := registeredRuleset.FromConfig
+	if registeredRuleset.Inspect != nil {
+		rulesetFactory = registeredRuleset.Inspect
+	}
+
+	rs, err := rulesetFactory(p, conf, slog.New(slog.DiscardHandler))
+	if err != nil {
+		return nil, err
+	}
+
+	rulesLister, ok := rs.(interface{ Rules() []rule.Rule })
+	if !ok {
+		return nil, fmt.Errorf("ruleset %s does not support listing its rules", conf.ID)
+	}
+	return rulesLister.Rules(), nil
+}
+
+// ProviderMetadata returns the metadata of a registered provider and its rulesets.
+func (r *Registry) ProviderMetadata(providerID string) (metadata.ProviderDetailed, error) {
+	registeredProvider, rulesets, err := r.get(providerID)
+	if err != nil {
+		return metadata.ProviderDetailed{}, err
+	}
+
+	providerMetadata := metadata.ProviderDetailed{
+		Provider: registeredProvider.Provider,
+		Rulesets: make([]metadata.Ruleset, 0, len(rulesets)),
+	}
+	for _, rs := range rulesets {
+		rulesetMetadata := rs.Ruleset
+		rulesetMetadata.Versions = slices.Clone(rs.Versions)
+		providerMetadata.Rulesets = append(providerMetadata.Rulesets, rulesetMetadata)
+	}
+	return providerMetadata, nil
+}
+
+// ArgsTypes returns the argument types accepted in the configuration of a registered provider and its rulesets.
+func (r *Registry) ArgsTypes(providerID string) (provider.ArgsTypes, error) {
+	registeredProvider, rulesets, err := r.get(providerID)
+	if err != nil {
+		return provider.ArgsTypes{}, err
+	}
+
+	argsTypes := provider.ArgsTypes{
+		Args:     registeredProvider.Args,
+		Rulesets: make(map[string]map[string]provider.RulesetArgsTypes, len(rulesets)),
+	}
+	for _, rs := range rulesets {
+		argsTypes.Rulesets[rs.ID] = make(map[string]provider.RulesetArgsTypes, len(rs.Versions))
+		for _, version := range rs.Versions {
+			rulesetArgsTypes := provider.RulesetArgsTypes{Args: rs.Args, InspectArgs: rs.InspectArgs, AnyRuleArgs: rs.AnyRuleArgs}
+			if rulesetArgsTypes.InspectArgs == nil {
+				rulesetArgsTypes.InspectArgs = rs.Args
+			}
+			if rs.RuleArgs != nil && !rs.AnyRuleArgs {
+				rulesetArgsTypes.RuleArgs = rs.RuleArgs(version.Version)
+			}
+			argsTypes.Rulesets[rs.ID][version.Version] = rulesetArgsTypes
+		}
+	}
+	return argsTypes, nil
+}
+
+// ProviderOption returns the [provider.ProviderOption] of a registered provider.
+// The functions of the option are evaluated lazily, so that they include rulesets registered later on.
+func (r *Registry) ProviderOption(providerID string) provider.ProviderOption {
+	return provider.ProviderOption{
+		ProviderFromConfigFunc: func(conf config.ProviderConfig) (provider.Provider, error) {
+			return r.ProviderFromConfig(providerID, conf)
+		},
+		MetadataFunc: func() metadata.ProviderDetailed {
+			providerMetadata, _ := r.ProviderMetadata(providerID)
+			return providerMetadata
+		},
+		RulesFromConfigFunc: func(conf config.RulesetConfig) ([]rule.Rule, error) {
+			return r.RulesFromConfig(providerID, conf)
+		},
+		ArgsTypesFunc: func() provider.ArgsTypes {
+			argsTypes, _ := r.ArgsTypes(providerID)
+			return argsTypes
+		},
+	}
+}
+
+// ProviderOptions returns the [provider.ProviderOption]s of all registered providers by provider ID.
+func (r *Registry) ProviderOptions() map[string]provider.ProviderOption {
+	r.mu.RLock()
+	providerIDs := slices.Collect(maps.Keys(r.providers))
+	r.mu.RUnlock()
+
+	providerOptions := make(map[string]provider.ProviderOption, len(providerIDs))
+	for _, providerID := range providerIDs {
+		providerOptions[providerID] = r.ProviderOption(providerID)
+	}
+	return providerOptions
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/registry"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+type fakeProvider struct {
+	id       string
+	offline  bool
+	rulesets []ruleset.Ruleset
+}
+
+func (p *fakeProvider) ID() string                  { return p.id }
+func (p *fakeProvider) Name() string                { return "Fake" }
+func (p *fakeProvider) Metadata() map[string]string { return nil }
+func (p *fakeProvider) RunAll(context.Context, ruleset.RunHooks) (provider.ProviderResult, error) {
+	return provider.ProviderResult{}, nil
+}
+func (p *fakeProvider) RunRuleset(context.Context, string, string, ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+func (p *fakeProvider) RunRule(context.Context, string, string, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+func (p *fakeProvider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	p.rulesets = append(p.rulesets, rulesets...)
+	return nil
+}
+
+type fakeRuleset struct {
+	id, version string
+	offline     bool
+}
+
+func (r *fakeRuleset) ID() string      { return r.id }
+func (r *fakeRuleset) Name() string    { return "Fake" }
+func (r *fakeRuleset) Version() string { return r.version }
+func (r *fakeRuleset) Run(context.Context, ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+func (r *fakeRuleset) RunRule(context.Context, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+func (r *fakeRuleset) Rules() []rule.Rule {
+	return []rule.Rule{rule.NewSkipRule("1", "offline", "", rule.Skipped)}
+}
+
+var _ = Describe("Registry", func() {
+	var (
+		r                  *registry.Registry
+		registeredProvider registry.Provider
+		registeredRuleset  registry.Ruleset
+	)
+
+	BeforeEach(func() {
+		r = registry.New()
+		registeredProvider = registry.Provider{
+			Provider: metadata.Provider{ID: "fake", Name: "Fake"},
+			Args:     struct{ Foo string }{},
+			FromConfig: func(conf config.ProviderConfig, _ *slog.Logger) (registry.RulesetProvider, error) {
+				return &fakeProvider{id: conf.ID}, nil
+			},
+			Offline: func() (registry.RulesetProvider, error) {
+				return &fakeProvider{id: "fake", offline: true}, nil
+			},
+		}
+		registeredRuleset = registry.Ruleset{
+			Ruleset:     metadata.Ruleset{ID: "foo", Name: "Foo", Versions: registry.Versions([]string{"v2", "v1"})},
+			Args:        struct{ Bar string }{},
+			InspectArgs: struct{ Bar string }{Bar: "bar"},
+			RuleArgs: func(version string) map[string]any {
+				return map[string]any{"1": version}
+			},
+			FromConfig: func(p provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+				if conf.Version == "invalid" {
+					return nil, errors.New("invalid version")
+				}
+				return &fakeRuleset{id: conf.ID, version: conf.Version, offline: p.(*fakeProvider).offline}, nil
+			},
+		}
+	})
+
+	It("should create providers with their rulesets", func() {
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(Succeed())
+		Expect(r.RegisterProvider(registeredProvider)).To(Succeed())
+
+		p, err := r.ProviderFromConfig("fake", config.ProviderConfig{
+			ID:       "fake",
+			Rulesets: []config.RulesetConfig{{ID: "foo", Version: "v1"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.(*fakeProvider).rulesets).To(Equal([]ruleset.Ruleset{&fakeRuleset{id: "foo", version: "v1"}}))
+
+		_, err = r.ProviderFromConfig("fake", config.ProviderConfig{ID: "fake", Rulesets: []config.RulesetConfig{{ID: "bar"}}})
+		Expect(err).To(MatchError("unknown ruleset identifier: bar"))
+
+		_, err = r.ProviderFromConfig("fake", config.ProviderConfig{ID: "fake", Rulesets: []config.RulesetConfig{{ID: "foo", Version: "invalid"}}})
+		Expect(err).To(MatchError("invalid version"))
+
+		_, err = r.ProviderFromConfig("bar", config.ProviderConfig{ID: "bar"})
+		Expect(err).To(MatchError("unknown provider identifier: bar"))
+	})
+
+	It("should return the rules of rulesets created with offline providers", func() {
+		Expect(r.RegisterProvider(registeredProvider)).To(Succeed())
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(Succeed())
+
+		rules, err := r.RulesFromConfig("fake", config.RulesetConfig{ID: "foo", Version: "v2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Name()).To(Equal("offline"))
+	})
+
+	It("should inspect rulesets with the inspect factory if it is set", func() {
+		registeredRuleset.Inspect = func(_ provider.Provider, conf config.RulesetConfig, _ *slog.Logger) (ruleset.Ruleset, error) {
+			if conf.Args != nil {
+				return nil, errors.New("unexpected args")
+			}
+			return &fakeRuleset{id: conf.ID, version: "inspected"}, nil
+		}
+		Expect(r.RegisterProvider(registeredProvider)).To(Succeed())
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(Succeed())
+
+		rules, err := r.RulesFromConfig("fake", config.RulesetConfig{ID: "foo", Version: "v2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(HaveLen(1))
+
+		_, err = r.RulesFromConfig("fake", config.RulesetConfig{ID: "foo", Version: "v2", Args: "foo"})
+		Expect(err).To(MatchError("unexpected args"))
+	})
+
+	It("should describe the registered providers and rulesets", func() {
+		Expect(r.RegisterProvider(registeredProvider)).To(Succeed())
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(Succeed())
+
+		providerOptions := r.ProviderOptions()
+		Expect(providerOptions).To(HaveKey("fake"))
+		Expect(providerOptions["fake"].MetadataFunc()).To(Equal(metadata.ProviderDetailed{
+			Provider: metadata.Provider{ID: "fake", Name: "Fake"},
+			Rulesets: []metadata.Ruleset{{
+				ID:       "foo",
+				Name:     "Foo",
+				Versions: []metadata.Version{{Version: "v2", Latest: true}, {Version: "v1"}},
+			}},
+		}))
+		Expect(providerOptions["fake"].ArgsTypesFunc()).To(Equal(provider.ArgsTypes{
+			Args: struct{ Foo string }{},
+			Rulesets: map[string]map[string]provider.RulesetArgsTypes{
+				"foo": {
+					"v2": {Args: struct{ Bar string }{}, InspectArgs: struct{ Bar string }{Bar: "bar"}, RuleArgs: map[string]any{"1": "v2"}},
+					"v1": {Args: struct{ Bar string }{}, InspectArgs: struct{ Bar string }{Bar: "bar"}, RuleArgs: map[string]any{"1": "v1"}},
+				},
+			},
+		}))
+
+		// rulesets registered after the options are created are included
+		Expect(r.RegisterRuleset("fake", registry.Ruleset{
+			Ruleset:    metadata.Ruleset{ID: "bar", Name: "Bar"},
+			FromConfig: registeredRuleset.FromConfig,
+		})).To(Succeed())
+		Expect(providerOptions["fake"].MetadataFunc().Rulesets).To(HaveLen(2))
+	})
+
+	It("should not register duplicated or incomplete entries", func() {
+		Expect(r.RegisterProvider(registeredProvider)).To(Succeed())
+		Expect(r.RegisterProvider(registeredProvider)).To(MatchError("provider with id fake is already registered"))
+		Expect(r.RegisterProvider(registry.Provider{Provider: metadata.Provider{ID: "bar"}})).To(MatchError("provider bar does not have a factory"))
+
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(Succeed())
+		Expect(r.RegisterRuleset("fake", registeredRuleset)).To(MatchError("ruleset with id foo is already registered for provider fake"))
+		Expect(r.RegisterRuleset("other", registeredRuleset)).To(Succeed())
+		Expect(func() { r.MustRegisterRuleset("fake", registry.Ruleset{}) }).To(Panic())
+	})
+})
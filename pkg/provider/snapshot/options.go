@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/shared/provider"
+)
+
+// CreateOption is a function that acts on a [Provider]
+// and is used to construct such objects.
+type CreateOption func(*Provider)
+
+// WithID sets the id of a [Provider].
+func WithID(id string) CreateOption {
+	return func(p *Provider) {
+		p.id = id
+	}
+}
+
+// WithName sets the name of a [Provider].
+func WithName(name string) CreateOption {
+	return func(p *Provider) {
+		p.name = name
+	}
+}
+
+// WithClient sets the Client of a [Provider] that serves the objects of the snapshot.
+func WithClient(c client.Client) CreateOption {
+	return func(p *Provider) {
+		p.Client = c
+	}
+}
+
+// WithMetadata sets the metadata of a [Provider].
+func WithMetadata(metadata map[string]string) CreateOption {
+	return func(p *Provider) {
+		p.metadata = metadata
+	}
+}
+
+// WithNumberOfWorkers sets the max number of Workers of a [Provider].
+func WithNumberOfWorkers(numWorkers int) CreateOption {
+	return func(p *Provider) {
+		if numWorkers <= 0 {
+			panic("number of workers should be a positive number")
+		}
+		p.numWorkers = numWorkers
+	}
+}
+
+// WithLogger sets the logger of a [Provider].
+func WithLogger(logger provider.Logger) CreateOption {
+	return func(p *Provider) {
+		p.logger = logger
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	kubesnapshot "github.com/gardener/diki/pkg/kubernetes/snapshot"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+const (
+	// ProviderID is a constant containing the id of the Snapshot provider.
+	ProviderID = "snapshot"
+	// ProviderName is a constant containing the user-friendly name of the Snapshot provider.
+	ProviderName = "Snapshot"
+	// MetadataSnapshotTime is the key of the provider metadata that holds the time at which the snapshot was taken.
+	MetadataSnapshotTime = "snapshotTime"
+	// UnsupportedRuleJustification is the justification of the rules that cannot be run against a snapshot.
+	UnsupportedRuleJustification = "Rule requires access to a live cluster, e.g. to exec into pods, and cannot be run against a snapshot."
+)
+
+// SupportedRules are the ids of the rules that can be run against a snapshot by ruleset id.
+// These rules only read objects that are contained in a snapshot. All other rules are reported as skipped.
+var SupportedRules = map[string][]string{
+	securityhardenedk8s.RulesetID: {"2000", "2001", "2002", "2003", "2004", "2005", "2006", "2007", "2008"},
+	disak8sstig.RulesetID: {
+		sharedrules.ID242383,
+		sharedrules.ID242395,
+		sharedrules.ID242414,
+		sharedrules.ID242415,
+		sharedrules.ID242417,
+		sharedrules.ID242442,
+	},
+}
+
+// Provider is a Snapshot Provider that runs rules against
+// the objects of a snapshot of a kubernetes cluster.
+type Provider struct {
+	id, name   string
+	Client     client.Client
+	rulesets   map[string]ruleset.Ruleset
+	metadata   map[string]string
+	numWorkers int
+	logger     sharedprovider.Logger
+}
+
+// ConfigArgs are the arguments of the provider configuration.
+type ConfigArgs struct {
+	// SnapshotPath is the path to a snapshot written by 'diki snapshot'.
+	SnapshotPath string `json:"snapshotPath" yaml:"snapshotPath"`
+}
+
+var _ provider.Provider = &Provider{}
+
+// New creates a new Provider.
+func New(options ...CreateOption) (*Provider, error) {
+	p := &Provider{
+		rulesets:   make(map[string]ruleset.Ruleset),
+		numWorkers: 1,
+	}
+	for _, o := range options {
+		o(p)
+	}
+
+	if p.Client == nil {
+		return nil, errors.New("snapshot client is nil")
+	}
+
+	return p, nil
+}
+
+// RunAll executes all Rulesets registered with the Provider.
+func (p *Provider) RunAll(ctx context.Context, hooks ruleset.RunHooks) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, hooks, p.numWorkers, p.Logger())
+}
+
+func rulesetKey(rulesetID, rulesetVersion string) string {
+	return rulesetID + "--" + rulesetVersion
+}
+
+// RunRuleset executes all Rules of a known Ruleset.
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+	return rs.Run(ctx, hooks)
+}
+
+// RunRule executes specific Rule of a known Ruleset.
+func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	return rs.RunRule(ctx, ruleID)
+}
+
+// AddRulesets adds Rulesets to Provider.
+func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	for _, r := range rulesets {
+		key := rulesetKey(r.ID(), r.Version())
+		if _, ok := p.rulesets[key]; ok {
+			return fmt.Errorf("ruleset with id %s and version %s already exists", r.ID(), r.Version())
+		}
+		p.rulesets[key] = r
+	}
+	return nil
+}
+
+// ID returns the id of the Provider.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the name of the Provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Metadata returns the metadata of the Provider.
+func (p *Provider) Metadata() map[string]string {
+	if p.metadata == nil {
+		p.metadata = map[string]string{}
+	}
+	return p.metadata
+}
+
+// FromGenericConfig creates a Provider from ProviderConfig.
+// The time at which the snapshot was taken is added to the provider
+// metadata unless the metadata already contains the [MetadataSnapshotTime] key.
+func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
+	if providerConf.NumWorkers < 0 {
+		return nil, errors.New("provider numWorkers should not be a negative number")
+	}
+
+	providerArgsByte, err := json.Marshal(providerConf.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerArgs ConfigArgs
+	if err := json.Unmarshal(providerArgsByte, &providerArgs); err != nil {
+		return nil, err
+	}
+
+	if len(providerArgs.SnapshotPath) == 0 {
+		return nil, errors.New("provider args snapshotPath must not be empty")
+	}
+
+	c, snapshotMetadata, err := kubesnapshot.LoadFile(providerArgs.SnapshotPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot %s: %w", providerArgs.SnapshotPath, err)
+	}
+
+	metadata := maps.Clone(providerConf.Metadata)
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	if _, ok := metadata[MetadataSnapshotTime]; !ok {
+		metadata[MetadataSnapshotTime] = snapshotMetadata.Time.Format(time.RFC3339)
+	}
+
+	provider, err := New(
+		WithID(providerConf.ID),
+		WithName(providerConf.Name),
+		WithClient(c),
+		WithMetadata(metadata),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if providerConf.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(providerConf.NumWorkers)
+		setNumWorkers(provider)
+	}
+
+	return provider, nil
+}
+
+// Logger returns the Provider's logger.
+// If not set it set it to slog.Default().With("provider", p.ID()) then return it.
+func (p *Provider) Logger() sharedprovider.Logger {
+	if p.logger == nil {
+		p.logger = slog.Default().With("provider", p.ID())
+	}
+	return p.logger
+}
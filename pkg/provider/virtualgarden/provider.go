@@ -36,10 +36,12 @@ type Provider struct {
 	RuntimeConfig          *rest.Config
 	rulesets               map[string]ruleset.Ruleset
 	metadata               map[string]string
+	numWorkers             int
 	logger                 *slog.Logger
 }
 
-type providerArgs struct {
+// ConfigArgs are the arguments of the provider configuration.
+type ConfigArgs struct {
 	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
 	RuntimeKubeconfigPath  string            `json:"runtimeKubeconfigPath" yaml:"runtimeKubeconfigPath"`
 }
@@ -49,7 +51,8 @@ var _ provider.Provider = &Provider{}
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
 	p := &Provider{
-		rulesets: make(map[string]ruleset.Ruleset),
+		rulesets:   make(map[string]ruleset.Ruleset),
+		numWorkers: 1,
 	}
 	for _, o := range options {
 		o(p)
@@ -68,8 +71,8 @@ func New(options ...CreateOption) (*Provider, error) {
 }
 
 // RunAll executes all Rulesets registered with the Provider.
-func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
-	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+func (p *Provider) RunAll(ctx context.Context, hooks ruleset.RunHooks) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, hooks, p.numWorkers, p.Logger())
 }
 
 func rulesetKey(rulesetID, rulesetVersion string) string {
@@ -77,12 +80,12 @@ func rulesetKey(rulesetID, rulesetVersion string) string {
 }
 
 // RunRuleset executes all Rules of a known Ruleset.
-func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
 	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
 	if !ok {
 		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
-	return rs.Run(ctx)
+	return rs.Run(ctx, hooks)
 }
 
 // RunRule executes specific Rule of a known Ruleset.
@@ -127,12 +130,16 @@ func (p *Provider) Metadata() map[string]string {
 
 // FromGenericConfig creates a Provider from ProviderConfig.
 func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
+	if providerConf.NumWorkers < 0 {
+		return nil, errors.New("provider numWorkers should not be a negative number")
+	}
+
 	providerArgsByte, err := json.Marshal(providerConf.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	var providerGardenArgs providerArgs
+	var providerGardenArgs ConfigArgs
 	if err := json.Unmarshal(providerArgsByte, &providerGardenArgs); err != nil {
 		return nil, err
 	}
@@ -153,6 +160,11 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
+	if providerConf.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(providerConf.NumWorkers)
+		setNumWorkers(gardenProvider)
+	}
+
 	return gardenProvider, nil
 }
 
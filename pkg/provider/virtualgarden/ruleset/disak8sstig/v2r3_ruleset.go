@@ -9,7 +9,6 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/labels"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
@@ -22,7 +21,7 @@ import (
 )
 
 func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	runtimeClient, err := client.New(r.RuntimeConfig, client.Options{})
+	runtimeClient, err := r.getRuntimeClient()
 	if err != nil {
 		return err
 	}
@@ -728,7 +727,7 @@ func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsCon
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithException(opt.Skip.Exception))
 		}
 	}
 
@@ -767,3 +766,11 @@ func getV2R3OptionOrNil[O rules.RuleOption](options any) (*O, error) {
 	}
 	return parseV2R3Options[O](options)
 }
+
+// v2r3RuleArgs contains zero values of the argument types accepted by the v2r3 rules.
+var v2r3RuleArgs = map[string]any{
+	sharedrules.ID242445: option.FileOwnerOptions{},
+	sharedrules.ID242446: option.FileOwnerOptions{},
+	sharedrules.ID242451: option.FileOwnerOptions{},
+	sharedrules.ID245543: sharedrules.Options245543{},
+}
@@ -130,7 +130,7 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 					ID:      id,
 					Name:    rulesetName,
 					Version: version,
-					Rules:   getRulesDifference(oldRuleset.Rules, newRuleset.Rules),
+					Rules:   getRulesDifference(provider, id, oldRuleset.Rules, newRuleset.Rules),
 				})
 			}
 		}
@@ -170,11 +170,11 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 	return diff, nil
 }
 
-func getRulesDifference(oldRules, newRules []Rule) []RuleDifference {
+func getRulesDifference(providerID, rulesetID string, oldRules, newRules []Rule) []RuleDifference {
 	var (
 		ruleDiff      []RuleDifference
-		addedChecks   = getCheckDifference(newRules, oldRules)
-		removedChecks = getCheckDifference(oldRules, newRules)
+		addedChecks   = getCheckDifference(providerID, rulesetID, newRules, oldRules)
+		removedChecks = getCheckDifference(providerID, rulesetID, oldRules, newRules)
 	)
 
 	for _, newCheck := range addedChecks {
@@ -212,8 +212,11 @@ func getRulesDifference(oldRules, newRules []Rule) []RuleDifference {
 }
 
 // getCheckDifference returns all rules with checks
-// that are present in rules1 but missing in rules2
-func getCheckDifference(rules1, rules2 []Rule) []Rule {
+// that are present in rules1 but missing in rules2.
+// Checks are matched by status and message and their targets by [Fingerprint],
+// so a check that is present in both rules is part of the difference
+// with the targets that are missing in rules2.
+func getCheckDifference(providerID, rulesetID string, rules1, rules2 []Rule) []Rule {
 	var uniqueRulesChecks []Rule
 	for _, rule1 := range rules1 {
 		var (
@@ -229,15 +232,47 @@ func getCheckDifference(rules1, rules2 []Rule) []Rule {
 		}
 
 		for _, check1 := range rule1.Checks {
-			oldCheckIdx := slices.IndexFunc(checks2, func(c Check) bool {
-				return c.Status == check1.Status && c.Message == check1.Message
-			})
+			var (
+				found         bool
+				fingerprints2 = map[string]struct{}{}
+			)
+			for _, check2 := range checks2 {
+				if check2.Status != check1.Status || check2.Message != check1.Message {
+					continue
+				}
+				found = true
+				// fingerprints are computed from the targets, so that reports without fingerprints can be compared
+				for _, fingerprint := range fingerprints(providerID, rulesetID, rule1.ID, check2.Targets) {
+					fingerprints2[fingerprint] = struct{}{}
+				}
+			}
 
-			if oldCheckIdx < 0 {
-				// we do not want targets in diff since they are not taken into account
-				check1.Targets = nil
-				difference = append(difference, check1)
+			var targets []rule.Target
+			if !found {
+				targets = check1.Targets
+			} else {
+				missing := false
+				for i, fingerprint := range fingerprints(providerID, rulesetID, rule1.ID, check1.Targets) {
+					if _, ok := fingerprints2[fingerprint]; ok {
+						continue
+					}
+					missing = true
+					if len(check1.Targets) > 0 {
+						targets = append(targets, check1.Targets[i])
+					}
+				}
+				if !missing {
+					continue
+				}
+			}
+
+			// only the targets that are part of the difference are kept
+			check1.Targets = nil
+			if len(targets) > 0 {
+				check1.Targets = targets
 			}
+			check1.Fingerprints = nil
+			difference = append(difference, check1)
 		}
 
 		if len(difference) > 0 {
@@ -294,26 +329,87 @@ func getUniqueRulesets(rulesets1, rulesets2 []Ruleset) map[string][]string {
 	return rulesets
 }
 
-// rulesetDiffAddedSummaryText returns a summary string with the number of added status types.
-func rulesetDiffAddedSummaryText(ruleset *RulesetDifference) string {
+// DifferenceSummary contains the number of added and removed checks per status of a DifferenceReport.
+type DifferenceSummary struct {
+	Title     string                     `json:"title,omitempty"`
+	Time      time.Time                  `json:"time"`
+	MinStatus rule.Status                `json:"minStatus,omitempty"`
+	Added     map[rule.Status]int        `json:"added"`
+	Removed   map[rule.Status]int        `json:"removed"`
+	Rulesets  []RulesetDifferenceSummary `json:"rulesets"`
+}
+
+// RulesetDifferenceSummary contains the number of added and removed checks per status of a ruleset.
+type RulesetDifferenceSummary struct {
+	ProviderID string              `json:"providerID"`
+	ID         string              `json:"id"`
+	Version    string              `json:"version"`
+	Added      map[rule.Status]int `json:"added"`
+	Removed    map[rule.Status]int `json:"removed"`
+}
+
+// Summary returns the number of added and removed checks per status of the difference.
+func (d *DifferenceReport) Summary() *DifferenceSummary {
+	summary := &DifferenceSummary{
+		Title:     d.Title,
+		Time:      d.Time,
+		MinStatus: d.MinStatus,
+		Added:     map[rule.Status]int{},
+		Removed:   map[rule.Status]int{},
+		Rulesets:  []RulesetDifferenceSummary{},
+	}
+
+	for _, provider := range d.Providers {
+		for _, ruleset := range provider.Rulesets {
+			rulesetSummary := RulesetDifferenceSummary{
+				ProviderID: provider.ID,
+				ID:         ruleset.ID,
+				Version:    ruleset.Version,
+				Added:      rulesetDiffAddedStatuses(&ruleset),
+				Removed:    rulesetDiffRemovedStatuses(&ruleset),
+			}
+			for status, num := range rulesetSummary.Added {
+				summary.Added[status] += num
+			}
+			for status, num := range rulesetSummary.Removed {
+				summary.Removed[status] += num
+			}
+			summary.Rulesets = append(summary.Rulesets, rulesetSummary)
+		}
+	}
+	return summary
+}
+
+// rulesetDiffAddedStatuses returns the number of added checks per status.
+func rulesetDiffAddedStatuses(ruleset *RulesetDifference) map[rule.Status]int {
 	var added = map[rule.Status]int{}
 	for _, rule := range ruleset.Rules {
 		for _, check := range rule.Added {
 			added[check.Status]++
 		}
 	}
-	return rulesetDiffSummaryText(added)
+	return added
 }
 
-// rulesetDiffRemovedSummaryText returns a summary string with the number of removed status types.
-func rulesetDiffRemovedSummaryText(ruleset *RulesetDifference) string {
+// rulesetDiffRemovedStatuses returns the number of removed checks per status.
+func rulesetDiffRemovedStatuses(ruleset *RulesetDifference) map[rule.Status]int {
 	var removed = map[rule.Status]int{}
 	for _, rule := range ruleset.Rules {
 		for _, check := range rule.Removed {
 			removed[check.Status]++
 		}
 	}
-	return rulesetDiffSummaryText(removed)
+	return removed
+}
+
+// rulesetDiffAddedSummaryText returns a summary string with the number of added status types.
+func rulesetDiffAddedSummaryText(ruleset *RulesetDifference) string {
+	return rulesetDiffSummaryText(rulesetDiffAddedStatuses(ruleset))
+}
+
+// rulesetDiffRemovedSummaryText returns a summary string with the number of removed status types.
+func rulesetDiffRemovedSummaryText(ruleset *RulesetDifference) string {
+	return rulesetDiffSummaryText(rulesetDiffRemovedStatuses(ruleset))
 }
 
 func rulesetDiffSummaryText(statusesCount map[rule.Status]int) string {
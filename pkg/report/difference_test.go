@@ -588,5 +588,94 @@ var _ = Describe("diff", func() {
 			Expect(diff).To(Equal(expectedDiff))
 			Expect(err).To(BeNil())
 		})
+		It("should create diff of targets that are added or removed under an existing message", func() {
+			var (
+				podA = rule.Target{"kind": "pod", "name": "pod-a"}
+				podB = rule.Target{"kind": "pod", "name": "pod-b"}
+				podC = rule.Target{"kind": "pod", "name": "pod-c"}
+			)
+			simpleReport1.Providers[0].Rulesets[0].Rules[1].Checks[1].Targets = []rule.Target{podA, podB}
+			simpleReport2.Providers[0].Rulesets[0].Rules[0].Checks = append(simpleReport2.Providers[0].Rulesets[0].Rules[0].Checks, report.Check{
+				Status:  rule.Failed,
+				Message: "foo",
+				Targets: []rule.Target{podA, podC},
+			})
+
+			diff, err := report.CreateDifference(simpleReport1, simpleReport2, title)
+
+			Expect(err).To(BeNil())
+			Expect(diff.Providers[0].Rulesets[0].Rules).To(ContainElement(report.RuleDifference{
+				ID:       "2",
+				Name:     "2",
+				Severity: rule.SeverityHigh,
+				Added:    []report.Check{{Status: rule.Failed, Message: "foo", Targets: []rule.Target{podC}}},
+				Removed:  []report.Check{{Status: rule.Failed, Message: "foo", Targets: []rule.Target{podB}}},
+			}))
+			Expect(diff.Summary().Added).To(HaveKeyWithValue(rule.Failed, 1))
+		})
+	})
+
+	Describe("#Summary", func() {
+		It("should count added and removed checks per status", func() {
+			diff := &report.DifferenceReport{
+				Title:     "Foo",
+				MinStatus: rule.Passed,
+				Providers: []report.ProviderDifference{
+					{
+						ID: "provider-foo",
+						Rulesets: []report.RulesetDifference{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.RuleDifference{
+									{
+										ID:      "1",
+										Added:   []report.Check{{Status: rule.Failed, Message: "foo"}, {Status: rule.Errored, Message: "bar"}},
+										Removed: []report.Check{{Status: rule.Passed, Message: "foo"}},
+									},
+									{
+										ID:    "2",
+										Added: []report.Check{{Status: rule.Failed, Message: "foo"}},
+									},
+								},
+							},
+							{
+								ID:      "ruleset-bar",
+								Version: "v2",
+								Rules: []report.RuleDifference{
+									{
+										ID:      "1",
+										Removed: []report.Check{{Status: rule.Failed, Message: "foo"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(diff.Summary()).To(Equal(&report.DifferenceSummary{
+				Title:     "Foo",
+				MinStatus: rule.Passed,
+				Added:     map[rule.Status]int{rule.Failed: 2, rule.Errored: 1},
+				Removed:   map[rule.Status]int{rule.Passed: 1, rule.Failed: 1},
+				Rulesets: []report.RulesetDifferenceSummary{
+					{
+						ProviderID: "provider-foo",
+						ID:         "ruleset-foo",
+						Version:    "v1",
+						Added:      map[rule.Status]int{rule.Failed: 2, rule.Errored: 1},
+						Removed:    map[rule.Status]int{rule.Passed: 1},
+					},
+					{
+						ProviderID: "provider-foo",
+						ID:         "ruleset-bar",
+						Version:    "v2",
+						Added:      map[rule.Status]int{},
+						Removed:    map[rule.Status]int{rule.Failed: 1},
+					},
+				},
+			}))
+		})
 	})
 })
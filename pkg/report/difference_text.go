@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// DifferenceTextRenderer renders difference reports as a unified text diff.
+// Each provider is rendered as a file header with its old and new metadata, each
+// ruleset as a hunk and each added or removed check as a line prefixed with '+' or '-'.
+type DifferenceTextRenderer struct{}
+
+var _ Renderer = &DifferenceTextRenderer{}
+
+// NewDifferenceTextRenderer creates a DifferenceTextRenderer.
+func NewDifferenceTextRenderer() *DifferenceTextRenderer {
+	return &DifferenceTextRenderer{}
+}
+
+// Render writes a difference report as a unified text diff into the passed writer.
+func (r *DifferenceTextRenderer) Render(w io.Writer, report any) error {
+	diff, ok := report.(*DifferenceReport)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	bw := bufio.NewWriter(w)
+	if len(diff.Title) > 0 {
+		fmt.Fprintf(bw, "%s\n", diff.Title)
+	}
+
+	for _, provider := range diff.Providers {
+		fmt.Fprintf(bw, "--- %s (%s)\n", provider.Name, targetText(rule.Target(provider.OldMetadata)))
+		fmt.Fprintf(bw, "+++ %s (%s)\n", provider.Name, targetText(rule.Target(provider.NewMetadata)))
+
+		for _, ruleset := range provider.Rulesets {
+			if len(ruleset.Rules) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(bw, "@@ %s %s: added %s, removed %s @@\n", ruleset.Version, ruleset.Name, rulesetDiffAddedSummaryText(&ruleset), rulesetDiffRemovedSummaryText(&ruleset))
+			for _, r := range ruleset.Rules {
+				fmt.Fprintf(bw, " %s\n", ruleTitle(r.ID, r.Severity, r.Name))
+				for _, check := range r.Removed {
+					writeCheckLines(bw, '-', check)
+				}
+				for _, check := range r.Added {
+					writeCheckLines(bw, '+', check)
+				}
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// writeCheckLines writes a line for each target of a check or a single line if the check has no targets.
+func writeCheckLines(w io.Writer, prefix rune, check Check) {
+	if len(check.Targets) == 0 {
+		fmt.Fprintf(w, "%c\t%s: %s\n", prefix, check.Status, check.Message)
+		return
+	}
+	for _, target := range check.Targets {
+		fmt.Fprintf(w, "%c\t%s: %s (%s)\n", prefix, check.Status, check.Message, targetText(target))
+	}
+}
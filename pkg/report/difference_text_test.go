@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("DifferenceTextRenderer", func() {
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+	})
+
+	It("should render a unified text diff", func() {
+		diff := &report.DifferenceReport{
+			Title: "Foo",
+			Providers: []report.ProviderDifference{
+				{
+					ID:          "provider-foo",
+					Name:        "Provider Foo",
+					OldMetadata: map[string]string{"id": "foo", "time": "2000-01-01T00:00:00Z"},
+					NewMetadata: map[string]string{"id": "foo", "time": "2000-01-02T00:00:00Z"},
+					Rulesets: []report.RulesetDifference{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.RuleDifference{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Added: []report.Check{{Status: rule.Failed, Message: "pod is privileged", Targets: []rule.Target{
+										{"kind": "pod", "name": "foo"},
+										{"kind": "pod", "name": "bar"},
+									}}},
+									Removed: []report.Check{{Status: rule.Passed, Message: "all good"}},
+								},
+								{
+									ID:      "2",
+									Name:    "Rule 2",
+									Removed: []report.Check{{Status: rule.Errored, Message: "timeout"}},
+								},
+							},
+						},
+						{
+							ID:      "ruleset-bar",
+							Name:    "Ruleset Bar",
+							Version: "v1",
+						},
+					},
+				},
+			},
+		}
+
+		Expect(report.NewDifferenceTextRenderer().Render(buf, diff)).To(Succeed())
+		Expect(buf.String()).To(Equal(`Foo
+--- Provider Foo (id=foo, time=2000-01-01T00:00:00Z)
++++ Provider Foo (id=foo, time=2000-01-02T00:00:00Z)
+@@ v1 Ruleset Foo: added 1x Failed 🔴, removed 1x Passed 🟢, 1x Errored 🔴 @@
+ 1 (High) - Rule 1
+-	Passed: all good
++	Failed: pod is privileged (kind=pod, name=foo)
++	Failed: pod is privileged (kind=pod, name=bar)
+ 2 - Rule 2
+-	Errored: timeout
+`))
+	})
+
+	It("should not render unknown report types", func() {
+		Expect(report.NewDifferenceTextRenderer().Render(buf, &report.Report{})).To(MatchError("unsupported report type: *report.Report"))
+	})
+})
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Expiration is a check produced by an exception that lapses after the time of the report.
+type Expiration struct {
+	ProviderName   string             `json:"providerName"`
+	RulesetName    string             `json:"rulesetName"`
+	RulesetVersion string             `json:"rulesetVersion"`
+	RuleID         string             `json:"ruleID"`
+	RuleName       string             `json:"ruleName"`
+	Severity       rule.SeverityLevel `json:"severity,omitempty"`
+	Check          Check              `json:"check"`
+	// Days is the number of days between the time of the report and the expiration date.
+	Days int `json:"days"`
+}
+
+// UpcomingExpirations returns the checks of the report whose exceptions have an
+// expiration date after the time of the report, ordered by their expiration date.
+func (r *Report) UpcomingExpirations() []Expiration {
+	var expirations []Expiration
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rr := range ruleset.Rules {
+				for _, check := range rr.Checks {
+					if check.Exception == nil || check.Exception.Lapsed(r.Time) {
+						continue
+					}
+					expiresAt, _ := check.Exception.Expiration()
+					if expiresAt.IsZero() {
+						continue
+					}
+					expirations = append(expirations, Expiration{
+						ProviderName:   provider.Name,
+						RulesetName:    ruleset.Name,
+						RulesetVersion: ruleset.Version,
+						RuleID:         rr.ID,
+						RuleName:       rr.Name,
+						Severity:       rr.Severity,
+						Check:          check,
+						Days:           int(expiresAt.Sub(r.Time).Hours() / 24),
+					})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(expirations, func(a, b Expiration) int {
+		return cmp.Compare(a.Check.Exception.ExpiresAt, b.Check.Exception.ExpiresAt)
+	})
+	return expirations
+}
+
+// exceptionText returns a string with the metadata of an exception.
+func exceptionText(exception *rule.Exception) string {
+	if exception == nil {
+		return ""
+	}
+
+	var texts []string
+	if len(exception.ExpiresAt) > 0 {
+		texts = append(texts, "expires at "+exception.ExpiresAt)
+	}
+	if len(exception.Owner) > 0 {
+		texts = append(texts, "owner: "+exception.Owner)
+	}
+	if len(exception.Reference) > 0 {
+		texts = append(texts, "reference: "+exception.Reference)
+	}
+	return strings.Join(texts, ", ")
+}
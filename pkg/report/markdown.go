@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	tmplMarkdownReportPath           = "templates/markdown/report.md"
+	tmplMarkdownMergedReportPath     = "templates/markdown/merged_report.md"
+	tmplMarkdownDifferenceReportPath = "templates/markdown/difference_report.md"
+)
+
+// markdownEscaper escapes characters that have a special meaning in
+// markdown. Line breaks are replaced so that text stays in its list item.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`|`, `\|`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// MarkdownRenderer renders Diki reports in markdown format, e.g. for pull request comments.
+// The rules of each status are rendered in a collapsible section.
+type MarkdownRenderer struct {
+	templates  map[string]*template.Template
+	maxTargets int
+}
+
+var _ Renderer = &MarkdownRenderer{}
+
+// NewMarkdownRenderer creates a MarkdownRenderer that lists at most maxTargets
+// targets per check. All targets are listed if maxTargets is not positive.
+func NewMarkdownRenderer(maxTargets int) (*MarkdownRenderer, error) {
+	r := &MarkdownRenderer{
+		templates:  make(map[string]*template.Template),
+		maxTargets: maxTargets,
+	}
+
+	convTimeFunc := func(time time.Time) string {
+		return time.Format("01-02-2006")
+	}
+	add := func(a, b int) int {
+		return a + b
+	}
+	keyExists := func(m map[string]string, k string) bool {
+		_, ok := m[k]
+		return ok
+	}
+	yamlFormat := func(m map[string]any) string {
+		yaml, err := yaml.Marshal(m)
+		if err != nil {
+			return err.Error()
+		}
+		return string(yaml)
+	}
+	statusIcon := func(status rule.Status) string {
+		return string(rule.StatusIcon(status))
+	}
+	commonFuncs := template.FuncMap{
+		"getStatuses":    rule.Statuses,
+		"statusIcon":     statusIcon,
+		"escape":         markdownEscaper.Replace,
+		"sortedMapKeys":  sortedKeys[string],
+		"ruleTitle":      ruleTitle,
+		"targetText":     targetText,
+		"limitTargets":   r.limitTargets,
+		"omittedTargets": r.omittedTargets,
+	}
+
+	for name, tmpl := range map[string]struct {
+		path  string
+		funcs template.FuncMap
+	}{
+		tmplReportName: {
+			path: tmplMarkdownReportPath,
+			funcs: template.FuncMap{
+				"time":               convTimeFunc,
+				"yamlFormat":         yamlFormat,
+				"rulesetSummaryText": rulesetSummaryText,
+				"rulesWithStatus":    rulesWithStatus,
+			},
+		},
+		tmplMergedReportName: {
+			path: tmplMarkdownMergedReportPath,
+			funcs: template.FuncMap{
+				"time":                     convTimeFunc,
+				"yamlFormat":               yamlFormat,
+				"mergedMetadataTexts":      metadataTextForMergedProvider,
+				"mergedRulesetSummaryText": mergedRulesetSummaryText,
+				"mergedRulesWithStatus":    mergedRulesWithStatus,
+			},
+		},
+		tmplDifferenceReportName: {
+			path: tmplMarkdownDifferenceReportPath,
+			funcs: template.FuncMap{
+				"add":                           add,
+				"keyExists":                     keyExists,
+				"getAttrString":                 getProviderDiffIDText,
+				"rulesetDiffAddedSummaryText":   rulesetDiffAddedSummaryText,
+				"rulesetDiffRemovedSummaryText": rulesetDiffRemovedSummaryText,
+				"mergeKeys":                     mergeKeys,
+				"rulesWithAdded":                rulesWithAdded,
+				"rulesWithRemoved":              rulesWithRemoved,
+			},
+		},
+	} {
+		funcs := maps.Clone(commonFuncs)
+		maps.Copy(funcs, tmpl.funcs)
+		parsed, err := template.New(name+".md").Funcs(funcs).ParseFS(files, tmpl.path)
+		if err != nil {
+			return nil, err
+		}
+		r.templates[name] = parsed
+	}
+	return r, nil
+}
+
+// Render writes a Diki report in markdown format into the passed writer.
+func (r *MarkdownRenderer) Render(w io.Writer, report any) error {
+	switch rep := report.(type) {
+	case *Report:
+		return r.templates[tmplReportName].Execute(w, rep)
+	case *MergedReport:
+		return r.templates[tmplMergedReportName].Execute(w, rep)
+	case *DifferenceReportsWrapper:
+		return r.templates[tmplDifferenceReportName].Execute(w, rep)
+	default:
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+}
+
+// limitTargets returns the non-empty targets that are listed for a check.
+func (r *MarkdownRenderer) limitTargets(targets []rule.Target) []rule.Target {
+	var limited []rule.Target
+	for _, target := range targets {
+		if r.maxTargets > 0 && len(limited) == r.maxTargets {
+			break
+		}
+		if len(target) > 0 {
+			limited = append(limited, target)
+		}
+	}
+	return limited
+}
+
+// omittedTargets returns the number of non-empty targets of a check that are not listed.
+func (r *MarkdownRenderer) omittedTargets(targets []rule.Target) int {
+	omitted := -len(r.limitTargets(targets))
+	for _, target := range targets {
+		if len(target) > 0 {
+			omitted++
+		}
+	}
+	return omitted
+}
+
+// mergeKeys returns a map with the keys of both maps.
+func mergeKeys(m1, m2 map[string]string) map[string]string {
+	merged := maps.Clone(m1)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for key := range m2 {
+		merged[key] = ""
+	}
+	return merged
+}
+
+// rulesWithAdded returns all rules that have added checks.
+func rulesWithAdded(rules []RuleDifference) []RuleDifference {
+	var result []RuleDifference
+	for _, r := range rules {
+		if len(r.Added) > 0 {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// rulesWithRemoved returns all rules that have removed checks.
+func rulesWithRemoved(rules []RuleDifference) []RuleDifference {
+	var result []RuleDifference
+	for _, r := range rules {
+		if len(r.Removed) > 0 {
+			result = append(result, r)
+		}
+	}
+	return result
+}
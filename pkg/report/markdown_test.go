@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("MarkdownRenderer", func() {
+	var (
+		buf *bytes.Buffer
+		rep *report.Report
+	)
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "v1.0.0",
+			Providers: []report.Provider{
+				{
+					ID:       "provider-foo",
+					Name:     "Provider Foo",
+					Metadata: map[string]string{"id": "foo"},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "2",
+									Name:     "Rule 2",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "pod is <privileged>",
+											Targets: []rule.Target{
+												rule.NewTarget("kind", "Pod", "name", "foo"),
+												rule.NewTarget("kind", "Pod", "name", "bar"),
+												rule.NewTarget("kind", "Pod", "name", "baz"),
+											},
+										},
+									},
+								},
+								{
+									ID:     "1",
+									Name:   "Rule *1*",
+									Checks: []report.Check{{Status: rule.Passed, Message: "all good"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render collapsible sections per status", func() {
+		renderer, err := report.NewMarkdownRenderer(0)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(renderer.Render(buf, rep)).To(Succeed())
+		Expect(buf.String()).To(Equal("# Compliance Run (01-01-2000)\n\n" +
+			"**Diki Version:** v1.0.0\n\n" +
+			"## Provider Provider Foo\n\n" +
+			"- **id**: foo\n\n" +
+			"### v1 Ruleset Foo (1x Passed 🟢, 1x Failed 🔴)\n\n" +
+			"<details>\n<summary>🟢 Passed (1)</summary>\n\n" +
+			"- **1 - Rule \\*1\\***\n" +
+			"  - all good\n\n" +
+			"</details>\n\n" +
+			"<details>\n<summary>🔴 Failed (1)</summary>\n\n" +
+			"- **2 (High) - Rule 2**\n" +
+			"  - pod is \\<privileged\\>\n" +
+			"    - `kind=Pod, name=foo`\n" +
+			"    - `kind=Pod, name=bar`\n" +
+			"    - `kind=Pod, name=baz`\n\n" +
+			"</details>\n"))
+	})
+
+	It("should cap the number of listed targets per check", func() {
+		renderer, err := report.NewMarkdownRenderer(2)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(renderer.Render(buf, rep)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("    - `kind=Pod, name=foo`\n" +
+			"    - `kind=Pod, name=bar`\n" +
+			"    - _and 1 more targets_\n"))
+		Expect(buf.String()).NotTo(ContainSubstring("name=baz"))
+	})
+
+	It("should render merged reports with the targets of each report", func() {
+		otherReport := *rep
+		otherReport.Providers = []report.Provider{rep.Providers[0]}
+		otherReport.Providers[0].Metadata = map[string]string{"id": "bar"}
+
+		mergedReport, err := report.MergeReport([]*report.Report{rep, &otherReport}, map[string]string{"provider-foo": "id"})
+		Expect(err).NotTo(HaveOccurred())
+
+		renderer, err := report.NewMarkdownRenderer(1)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(renderer.Render(buf, mergedReport)).To(Succeed())
+		Expect(buf.String()).To(And(
+			ContainSubstring("- **bar** (time: 01-01-2000 00:00:00)\n- **foo** (time: 01-01-2000 00:00:00)\n"),
+			ContainSubstring("<summary>🔴 Failed (1)</summary>\n\n"+
+				"- **2 (High) - Rule 2**\n"+
+				"  - pod is \\<privileged\\>\n"+
+				"    - **bar**\n"+
+				"      - `kind=Pod, name=foo`\n"+
+				"      - _and 2 more targets_\n"+
+				"    - **foo**\n"+
+				"      - `kind=Pod, name=foo`\n"+
+				"      - _and 2 more targets_\n\n"+
+				"</details>\n"),
+		))
+	})
+
+	It("should render difference reports with collapsible added and removed sections", func() {
+		newReport := *rep
+		newReport.Providers = []report.Provider{rep.Providers[0]}
+		newReport.Providers[0].Rulesets = []report.Ruleset{rep.Providers[0].Rulesets[0]}
+		newReport.Providers[0].Rulesets[0].Rules = []report.Rule{
+			{ID: "2", Name: "Rule 2", Severity: rule.SeverityHigh, Checks: []report.Check{{Status: rule.Passed, Message: "fixed"}}},
+			rep.Providers[0].Rulesets[0].Rules[1],
+		}
+
+		diff, err := report.CreateDifference(*rep, newReport, "Title")
+		Expect(err).NotTo(HaveOccurred())
+
+		renderer, err := report.NewMarkdownRenderer(0)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(renderer.Render(buf, &report.DifferenceReportsWrapper{
+			DifferenceReports:  []*report.DifferenceReport{diff},
+			IdentityAttributes: map[string]string{"provider-foo": "id"},
+		})).To(Succeed())
+		Expect(buf.String()).To(Equal("# Difference Report\n\n" +
+			"## 1. Title\n\n" +
+			"### Provider Provider Foo - foo\n\n" +
+			"| Metadata | Old | New |\n" +
+			"| --- | --- | --- |\n" +
+			"| id | foo | foo |\n" +
+			"| time | 2000-01-01T00:00:00Z | 2000-01-01T00:00:00Z |\n\n" +
+			"#### v1 Ruleset Foo\n\n" +
+			"**Added statuses:** 1x Passed 🟢  \n" +
+			"**Removed statuses:** 1x Failed 🔴\n\n" +
+			"<details>\n<summary>Added (1)</summary>\n\n" +
+			"- **2 (High) - Rule 2**\n" +
+			"  - 🟢 Passed: fixed\n\n" +
+			"</details>\n\n" +
+			"<details>\n<summary>Removed (1)</summary>\n\n" +
+			"- **2 (High) - Rule 2**\n" +
+			"  - 🔴 Failed: pod is \\<privileged\\>\n\n" +
+			"</details>\n"))
+	})
+
+	It("should not render unknown report types", func() {
+		renderer, err := report.NewMarkdownRenderer(0)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(renderer.Render(buf, "foo")).To(MatchError("unsupported report type: string"))
+	})
+})
@@ -6,11 +6,13 @@ package report
 
 import (
 	"embed"
+	"encoding/xml"
 	"fmt"
 	"html/template"
 	"io"
 	"maps"
 	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -25,21 +27,49 @@ const (
 	tmplMergedReportPath     = "templates/html/merged_report.html"
 	tmplDifferenceReportName = "difference_report"
 	tmplDifferenceReportPath = "templates/html/difference_report.html"
+	tmplTrendReportName      = "trend_report"
+	tmplTrendReportPath      = "templates/html/trend_report.html"
 	tmplStylesPath           = "templates/html/_styles.tpl"
 )
 
 var (
-	//go:embed templates/html/*
+	//go:embed templates/html/* templates/markdown/*
 	files embed.FS
 )
 
+// Renderer renders Diki reports into a writer.
+type Renderer interface {
+	Render(w io.Writer, report any) error
+}
+
 // HTMLRenderer renders Diki reports in html format.
 type HTMLRenderer struct {
-	templates map[string]*template.Template
+	templates    map[string]*template.Template
+	verification *Verification
+}
+
+var _ Renderer = &HTMLRenderer{}
+
+// HTMLRendererOption configures a HTMLRenderer.
+type HTMLRendererOption func(*HTMLRenderer)
+
+// WithVerification embeds the result of the verification of the
+// report signature in the footer of rendered reports.
+func WithVerification(verification *Verification) HTMLRendererOption {
+	return func(r *HTMLRenderer) {
+		r.verification = verification
+	}
 }
 
 // NewHTMLRenderer creates a HTMLRenderer.
-func NewHTMLRenderer() (*HTMLRenderer, error) {
+func NewHTMLRenderer(opts ...HTMLRendererOption) (*HTMLRenderer, error) {
+	renderer := &HTMLRenderer{
+		templates: make(map[string]*template.Template),
+	}
+	for _, opt := range opts {
+		opt(renderer)
+	}
+
 	convTimeFunc := func(time time.Time) string {
 		return time.Format("01-02-2006")
 	}
@@ -57,7 +87,7 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 		}
 		return string(yaml)
 	}
-	templates := make(map[string]*template.Template)
+	templates := renderer.templates
 
 	parsedReport, err := template.New(tmplReportName+".html").Funcs(template.FuncMap{
 		"getStatuses":        rule.Statuses,
@@ -69,6 +99,8 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 		"rulesWithStatus":    rulesWithStatus,
 		"sortedMapKeys":      sortedKeys[string],
 		"ruleTitle":          ruleTitle,
+		"exceptionText":      exceptionText,
+		"verification":       func() *Verification { return renderer.verification },
 	}).ParseFS(files, tmplReportPath, tmplStylesPath)
 	if err != nil {
 		return nil, err
@@ -109,9 +141,25 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 	}
 	templates[tmplDifferenceReportName] = parsedDifferenceReport
 
-	return &HTMLRenderer{
-		templates: templates,
-	}, nil
+	parsedTrendReport, err := template.New(tmplTrendReportName+".html").Funcs(template.FuncMap{
+		"getStatuses":       rule.Statuses,
+		"statusIcon":        rule.StatusIcon,
+		"statusDescription": rule.StatusDescription,
+		"statusColor":       statusColor,
+		"time":              convTimeFunc,
+		"lastIndex":         func(times []time.Time) int { return len(times) - 1 },
+		"trendChart":        newTrendChart,
+		"openFindings":      openFindings,
+		"resolvedFindings":  resolvedFindings,
+		"ruleTitle":         ruleTitle,
+		"targetText":        targetText,
+	}).ParseFS(files, tmplTrendReportPath, tmplStylesPath)
+	if err != nil {
+		return nil, err
+	}
+	templates[tmplTrendReportName] = parsedTrendReport
+
+	return renderer, nil
 }
 
 // Render writes a Diki report in html format into the passed writer.
@@ -123,11 +171,183 @@ func (r *HTMLRenderer) Render(w io.Writer, report any) error {
 		return r.templates[tmplMergedReportName].Execute(w, rep)
 	case *DifferenceReportsWrapper:
 		return r.templates[tmplDifferenceReportName].Execute(w, rep)
+	case *TrendReport:
+		return r.templates[tmplTrendReportName].Execute(w, rep)
 	default:
 		return fmt.Errorf("unsupported report type: %T", report)
 	}
 }
 
+// JUnitRenderer renders Diki reports in JUnit XML format.
+// Each ruleset of a provider is rendered as a test suite and each rule as a test case.
+// Rules with Failed checks are failures, rules with Errored checks are errors and rules
+// with only Skipped, Accepted or Not Implemented checks are skipped.
+type JUnitRenderer struct{}
+
+var _ Renderer = &JUnitRenderer{}
+
+// NewJUnitRenderer creates a JUnitRenderer.
+func NewJUnitRenderer() *JUnitRenderer {
+	return &JUnitRenderer{}
+}
+
+// Render writes a Diki report in JUnit XML format into the passed writer.
+func (r *JUnitRenderer) Render(w io.Writer, report any) error {
+	rep, ok := report.(*Report)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuitesFromReport(rep)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitResult  `xml:"failure,omitempty"`
+	Error     *junitResult  `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut *junitText    `xml:"system-out,omitempty"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",cdata"`
+}
+
+type junitText struct {
+	Text string `xml:",cdata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+func junitTestSuitesFromReport(report *Report) junitTestSuites {
+	testSuites := junitTestSuites{Name: "diki"}
+	for _, provider := range report.Providers {
+		for _, ruleset := range provider.Rulesets {
+			testSuite := junitTestSuite{
+				Name:      fmt.Sprintf("%s/%s/%s", provider.ID, ruleset.ID, ruleset.Version),
+				Timestamp: report.Time.UTC().Format("2006-01-02T15:04:05"),
+				Properties: []junitProperty{
+					{Name: "provider", Value: provider.Name},
+					{Name: "ruleset", Value: ruleset.Name},
+					{Name: "dikiVersion", Value: report.DikiVersion},
+				},
+			}
+			for _, key := range sortedKeys(provider.Metadata) {
+				testSuite.Properties = append(testSuite.Properties, junitProperty{Name: "metadata." + key, Value: provider.Metadata[key]})
+			}
+
+			className := fmt.Sprintf("%s.%s.%s", provider.ID, ruleset.ID, ruleset.Version)
+			for _, r := range ruleset.Rules {
+				testCase := junitTestCaseFromRule(className, r)
+				switch {
+				case testCase.Failure != nil:
+					testSuite.Failures++
+				case testCase.Error != nil:
+					testSuite.Errors++
+				case testCase.Skipped != nil:
+					testSuite.Skipped++
+				}
+				testSuite.Tests++
+				testSuite.TestCases = append(testSuite.TestCases, testCase)
+			}
+
+			testSuites.Tests += testSuite.Tests
+			testSuites.Failures += testSuite.Failures
+			testSuites.Errors += testSuite.Errors
+			testSuites.Skipped += testSuite.Skipped
+			testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+		}
+	}
+	return testSuites
+}
+
+func junitTestCaseFromRule(className string, r Rule) junitTestCase {
+	testCase := junitTestCase{
+		Name:      ruleTitle(r.ID, r.Severity, r.Name),
+		ClassName: className,
+	}
+
+	checksWithStatus := func(status rule.Status) []Check {
+		var checks []Check
+		for _, check := range r.Checks {
+			if check.Status == status {
+				checks = append(checks, check)
+			}
+		}
+		return checks
+	}
+
+	// a test case can only have a failure or an error, so errored checks of failed rules are part of the failure
+	failed, errored := checksWithStatus(rule.Failed), checksWithStatus(rule.Errored)
+	if len(failed) > 0 {
+		testCase.Failure = &junitResult{Message: failed[0].Message, Type: string(rule.Failed), Body: junitChecksText(slices.Concat(failed, errored))}
+	} else if len(errored) > 0 {
+		testCase.Error = &junitResult{Message: errored[0].Message, Type: string(rule.Errored), Body: junitChecksText(errored)}
+	} else if len(r.Checks) > 0 && !slices.ContainsFunc(r.Checks, func(c Check) bool {
+		return c.Status != rule.Skipped && c.Status != rule.Accepted && c.Status != rule.NotImplemented
+	}) {
+		testCase.Skipped = &junitSkipped{Message: r.Checks[0].Message}
+	}
+
+	if len(r.Checks) > 0 {
+		testCase.SystemOut = &junitText{Text: junitChecksText(r.Checks)}
+	}
+	return testCase
+}
+
+// junitChecksText returns the status, message and targets of each check.
+func junitChecksText(checks []Check) string {
+	var text strings.Builder
+	for _, check := range checks {
+		fmt.Fprintf(&text, "%s: %s\n", check.Status, check.Message)
+		for _, target := range check.Targets {
+			fmt.Fprintf(&text, "  - %s\n", targetText(target))
+		}
+	}
+	return text.String()
+}
+
 func sortedKeys[T any](m map[string]T) []string {
 	return slices.Sorted(maps.Keys(m))
 }
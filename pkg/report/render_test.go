@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("JUnitRenderer", func() {
+	var (
+		renderer *report.JUnitRenderer
+		buf      *bytes.Buffer
+		rep      *report.Report
+	)
+
+	BeforeEach(func() {
+		renderer = report.NewJUnitRenderer()
+		buf = &bytes.Buffer{}
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "v1.0.0",
+			Providers: []report.Provider{
+				{
+					ID:       "provider-foo",
+					Name:     "Provider Foo",
+					Metadata: map[string]string{"foo": "bar"},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "pod is privileged",
+											Targets: []rule.Target{rule.NewTarget("kind", "Pod", "name", "foo")},
+										},
+										{Status: rule.Errored, Message: "cannot list nodes"},
+									},
+								},
+								{
+									ID:     "2",
+									Name:   "Rule 2",
+									Checks: []report.Check{{Status: rule.Errored, Message: "cannot list nodes"}},
+								},
+								{
+									ID:     "3",
+									Name:   "Rule 3",
+									Checks: []report.Check{{Status: rule.Accepted, Message: "accepted"}, {Status: rule.Skipped, Message: "skipped"}},
+								},
+								{
+									ID:     "4",
+									Name:   "Rule 4",
+									Checks: []report.Check{{Status: rule.Passed, Message: "passed"}, {Status: rule.Accepted, Message: "accepted"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render a report in JUnit XML format", func() {
+		Expect(renderer.Render(buf, rep)).To(Succeed())
+		Expect(buf.String()).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="diki" tests="4" failures="1" errors="1" skipped="1">
+  <testsuite name="provider-foo/ruleset-foo/v1" tests="4" failures="1" errors="1" skipped="1" timestamp="2000-01-01T00:00:00">
+    <properties>
+      <property name="provider" value="Provider Foo"></property>
+      <property name="ruleset" value="Ruleset Foo"></property>
+      <property name="dikiVersion" value="v1.0.0"></property>
+      <property name="metadata.foo" value="bar"></property>
+    </properties>
+    <testcase name="1 (High) - Rule 1" classname="provider-foo.ruleset-foo.v1">
+      <failure message="pod is privileged" type="Failed"><![CDATA[Failed: pod is privileged
+  - kind=Pod, name=foo
+Errored: cannot list nodes
+]]></failure>
+      <system-out><![CDATA[Failed: pod is privileged
+  - kind=Pod, name=foo
+Errored: cannot list nodes
+]]></system-out>
+    </testcase>
+    <testcase name="2 - Rule 2" classname="provider-foo.ruleset-foo.v1">
+      <error message="cannot list nodes" type="Errored"><![CDATA[Errored: cannot list nodes
+]]></error>
+      <system-out><![CDATA[Errored: cannot list nodes
+]]></system-out>
+    </testcase>
+    <testcase name="3 - Rule 3" classname="provider-foo.ruleset-foo.v1">
+      <skipped message="accepted"></skipped>
+      <system-out><![CDATA[Accepted: accepted
+Skipped: skipped
+]]></system-out>
+    </testcase>
+    <testcase name="4 - Rule 4" classname="provider-foo.ruleset-foo.v1">
+      <system-out><![CDATA[Passed: passed
+Accepted: accepted
+]]></system-out>
+    </testcase>
+  </testsuite>
+</testsuites>
+`))
+	})
+
+	It("should not render merged reports", func() {
+		Expect(renderer.Render(buf, &report.MergedReport{})).To(MatchError("unsupported report type: *report.MergedReport"))
+	})
+})
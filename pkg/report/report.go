@@ -6,11 +6,14 @@ package report
 
 import (
 	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
 	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/component-base/version"
@@ -27,7 +30,9 @@ type Report struct {
 	MinStatus   rule.Status    `json:"minStatus,omitempty"`
 	DikiVersion string         `json:"dikiVersion"`
 	Metadata    map[string]any `json:"metadata,omitempty"`
-	Providers   []Provider     `json:"providers"`
+	// RuleFilter is the filter that selected the rules of the run.
+	RuleFilter *ruleset.RuleFilter `json:"ruleFilter,omitempty"`
+	Providers  []Provider          `json:"providers"`
 }
 
 // Provider contains information about a known provider
@@ -41,10 +46,12 @@ type Provider struct {
 
 // Ruleset contains information about a rule set and its rules.
 type Ruleset struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	Version string `json:"version"`
-	Rules   []Rule `json:"rules"`
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Version       string   `json:"version"`
+	TimedOutRules []string `json:"timedOutRules,omitempty"`
+	ResumedRules  []string `json:"resumedRules,omitempty"`
+	Rules         []Rule   `json:"rules"`
 }
 
 // Rule contains information about a ran rule.
@@ -60,12 +67,19 @@ type Check struct {
 	Status  rule.Status   `json:"status"`
 	Message string        `json:"message"`
 	Targets []rule.Target `json:"targets,omitempty"`
+	// Fingerprints identify the findings of the check across runs. They contain the
+	// [Fingerprint] of each target in the order of Targets or a single fingerprint
+	// if the check has no targets.
+	Fingerprints []string `json:"fingerprints,omitempty"`
+	// Exception is the metadata of the acceptance or skip that produced the check, if any.
+	Exception *rule.Exception `json:"exception,omitempty"`
 }
 
 // ReportOptions are options that can be applied to a Report.
 type ReportOptions struct {
-	MinStatus rule.Status
-	Metadata  map[string]any
+	MinStatus  rule.Status
+	Metadata   map[string]any
+	RuleFilter *ruleset.RuleFilter
 }
 
 // ReportOption defines a single option that can be applied to a Report.
@@ -91,6 +105,16 @@ func (md Metadata) ApplyToReport(opts *ReportOptions) {
 	opts.Metadata = maps.Clone(md)
 }
 
+// RuleFilter is the filter that selected the rules of the run.
+type RuleFilter ruleset.RuleFilter
+
+// ApplyToReport implements ReportOption.
+func (rf RuleFilter) ApplyToReport(opts *ReportOptions) {
+	if filter := ruleset.RuleFilter(rf); !filter.IsEmpty() {
+		opts.RuleFilter = &filter
+	}
+}
+
 // FromProviderResults returns a Diki report from ProviderResults.
 func FromProviderResults(results []provider.ProviderResult, options ...ReportOption) *Report {
 	opts := &ReportOptions{}
@@ -102,6 +126,7 @@ func FromProviderResults(results []provider.ProviderResult, options ...ReportOpt
 		MinStatus:   opts.MinStatus,
 		DikiVersion: version.Get().GitVersion,
 		Metadata:    opts.Metadata,
+		RuleFilter:  opts.RuleFilter,
 		Providers:   make([]Provider, 0, len(results)),
 	}
 	for _, providerResult := range results {
@@ -109,7 +134,7 @@ func FromProviderResults(results []provider.ProviderResult, options ...ReportOpt
 			ID:       providerResult.ProviderID,
 			Name:     providerResult.ProviderName,
 			Metadata: providerResult.Metadata,
-			Rulesets: getRulesets(providerResult.RulesetResults, opts),
+			Rulesets: getRulesets(providerResult.ProviderID, providerResult.RulesetResults, opts),
 		}
 		report.Providers = append(report.Providers, p)
 	}
@@ -169,6 +194,16 @@ func ruleTitle(id string, severity rule.SeverityLevel, name string) string {
 	return fmt.Sprintf("%s (%s) - %s", id, severity, name)
 }
 
+// targetText returns the key-value pairs of a target sorted by key, e.g. "kind=Pod, name=foo".
+func targetText(target rule.Target) string {
+	keys := slices.Sorted(maps.Keys(target))
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, target[key]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
 func numOfRulesWithStatus(ruleset *Ruleset, status rule.Status) int {
 	num := 0
 	for _, rule := range ruleset.Rules {
@@ -203,21 +238,23 @@ func rulesWithStatus(ruleset *Ruleset, status rule.Status) []Rule {
 	return result
 }
 
-func getRulesets(rulesetResults []ruleset.RulesetResult, opts *ReportOptions) []Ruleset {
+func getRulesets(providerID string, rulesetResults []ruleset.RulesetResult, opts *ReportOptions) []Ruleset {
 	rulesets := make([]Ruleset, 0, len(rulesetResults))
 	for _, rulesetResult := range rulesetResults {
 		rs := Ruleset{
-			ID:      rulesetResult.RulesetID,
-			Name:    rulesetResult.RulesetName,
-			Version: rulesetResult.RulesetVersion,
-			Rules:   getRules(rulesetResult.RuleResults, opts),
+			ID:            rulesetResult.RulesetID,
+			Name:          rulesetResult.RulesetName,
+			Version:       rulesetResult.RulesetVersion,
+			TimedOutRules: rulesetResult.TimedOutRules,
+			ResumedRules:  rulesetResult.ResumedRules,
+			Rules:         getRules(providerID, rulesetResult.RulesetID, rulesetResult.RuleResults, opts),
 		}
 		rulesets = append(rulesets, rs)
 	}
 	return rulesets
 }
 
-func getRules(ruleResults []rule.RuleResult, opts *ReportOptions) []Rule {
+func getRules(providerID, rulesetID string, ruleResults []rule.RuleResult, opts *ReportOptions) []Rule {
 	rules := make([]Rule, 0, len(ruleResults))
 	for _, ruleResult := range ruleResults {
 		r := Rule{
@@ -226,8 +263,16 @@ func getRules(ruleResults []rule.RuleResult, opts *ReportOptions) []Rule {
 			Severity: ruleResult.Severity,
 			Checks:   getChecks(ruleResult.CheckResults, opts),
 		}
+		for i := range r.Checks {
+			r.Checks[i].Fingerprints = fingerprints(providerID, rulesetID, r.ID, r.Checks[i].Targets)
+		}
 		rules = append(rules, r)
 	}
+
+	// sort rules by id since rules finish in random order
+	slices.SortFunc(rules, func(a, b Rule) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
 	return rules
 }
 
@@ -237,12 +282,13 @@ func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
 		if opts.MinStatus != "" && checkResult.Status.Less(opts.MinStatus) {
 			continue
 		}
-		key := fmt.Sprintf("%s--%s", checkResult.Status, checkResult.Message)
+		key := fmt.Sprintf("%s--%s--%s", checkResult.Status, checkResult.Message, exceptionKey(checkResult.Exception))
 		check, ok := groupedChecks[key]
 		if !ok {
 			check := &Check{
-				Status:  checkResult.Status,
-				Message: checkResult.Message,
+				Status:    checkResult.Status,
+				Message:   checkResult.Message,
+				Exception: checkResult.Exception,
 			}
 
 			if len(checkResult.Target) > 0 {
@@ -256,7 +302,63 @@ func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
 
 	checks := make([]Check, 0, len(groupedChecks))
 	for _, check := range groupedChecks {
+		slices.SortStableFunc(check.Targets, func(a, b rule.Target) int {
+			return cmp.Compare(targetText(a), targetText(b))
+		})
 		checks = append(checks, *check)
 	}
+
+	// sort checks by status, message and exception to ensure static order
+	slices.SortFunc(checks, func(a, b Check) int {
+		return cmp.Or(compareStatus(a.Status, b.Status), cmp.Compare(a.Message, b.Message), cmp.Compare(exceptionKey(a.Exception), exceptionKey(b.Exception)))
+	})
 	return checks
 }
+
+// exceptionKey returns a string that identifies the metadata of an exception.
+func exceptionKey(exception *rule.Exception) string {
+	if exception == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s--%s--%s", exception.ExpiresAt, exception.Owner, exception.Reference)
+}
+
+// compareStatus compares two statuses by their priority.
+func compareStatus(a, b rule.Status) int {
+	switch {
+	case a.Less(b):
+		return -1
+	case b.Less(a):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Fingerprint returns a deterministic identifier of the finding of a rule for a target.
+// It does not depend on the status and message of the check, so that a finding
+// can be tracked across runs. The ruleset version is not part of the fingerprint.
+func Fingerprint(providerID, rulesetID, ruleID string, target rule.Target) string {
+	// quoting keeps the encoding unambiguous
+	h := sha256.New()
+	fmt.Fprintf(h, "%q %q %q", providerID, rulesetID, ruleID)
+	for _, key := range slices.Sorted(maps.Keys(target)) {
+		fmt.Fprintf(h, " %q=%q", key, target[key])
+	}
+
+	sum := h.Sum(nil)
+	return hex.EncodeToString(sum[:16])
+}
+
+// fingerprints returns the fingerprints of the targets of a check.
+func fingerprints(providerID, rulesetID, ruleID string, targets []rule.Target) []string {
+	if len(targets) == 0 {
+		return []string{Fingerprint(providerID, rulesetID, ruleID, nil)}
+	}
+
+	result := make([]string, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, Fingerprint(providerID, rulesetID, ruleID, target))
+	}
+	return result
+}
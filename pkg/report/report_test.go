@@ -5,13 +5,18 @@
 package report_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/report"
 	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
 )
 
 var _ = Describe("report", func() {
@@ -172,4 +177,179 @@ var _ = Describe("report", func() {
 		})
 	})
 
+	Describe("#FromProviderResults", func() {
+		var (
+			fooTarget = rule.NewTarget("kind", "Pod", "name", "foo")
+			barTarget = rule.NewTarget("kind", "Pod", "name", "bar")
+			results   []provider.ProviderResult
+		)
+
+		BeforeEach(func() {
+			results = []provider.ProviderResult{
+				{
+					ProviderID:   "provider-foo",
+					ProviderName: "Provider Foo",
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "ruleset-foo",
+							RulesetName:    "Ruleset Foo",
+							RulesetVersion: "v1",
+							RuleResults: []rule.RuleResult{
+								{
+									RuleID:   "2",
+									RuleName: "Rule 2",
+									CheckResults: []rule.CheckResult{
+										rule.FailedCheckResult("foo", fooTarget),
+										rule.PassedCheckResult("bar", rule.NewTarget()),
+										rule.FailedCheckResult("foo", barTarget),
+										rule.FailedCheckResult("bar", fooTarget),
+									},
+								},
+								{
+									RuleID:       "1",
+									RuleName:     "Rule 1",
+									CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should sort rules, checks and targets and add fingerprints", func() {
+			rep := report.FromProviderResults(results)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules).To(Equal([]report.Rule{
+				{
+					ID:   "1",
+					Name: "Rule 1",
+					Checks: []report.Check{
+						{
+							Status:       rule.Passed,
+							Message:      "foo",
+							Fingerprints: []string{report.Fingerprint("provider-foo", "ruleset-foo", "1", nil)},
+						},
+					},
+				},
+				{
+					ID:   "2",
+					Name: "Rule 2",
+					Checks: []report.Check{
+						{
+							Status:       rule.Passed,
+							Message:      "bar",
+							Fingerprints: []string{report.Fingerprint("provider-foo", "ruleset-foo", "2", nil)},
+						},
+						{
+							Status:       rule.Failed,
+							Message:      "bar",
+							Targets:      []rule.Target{fooTarget},
+							Fingerprints: []string{report.Fingerprint("provider-foo", "ruleset-foo", "2", fooTarget)},
+						},
+						{
+							Status:  rule.Failed,
+							Message: "foo",
+							Targets: []rule.Target{barTarget, fooTarget},
+							Fingerprints: []string{
+								report.Fingerprint("provider-foo", "ruleset-foo", "2", barTarget),
+								report.Fingerprint("provider-foo", "ruleset-foo", "2", fooTarget),
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should produce identical reports for identical results", func() {
+			rep1 := report.FromProviderResults(results)
+			slices.Reverse(results[0].RulesetResults[0].RuleResults)
+			for _, ruleResult := range results[0].RulesetResults[0].RuleResults {
+				slices.Reverse(ruleResult.CheckResults)
+			}
+			rep2 := report.FromProviderResults(results)
+
+			rep2.Time = rep1.Time
+			data1, err := json.Marshal(rep1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Marshal(rep2)).To(Equal(data1))
+		})
+	})
+
+	Describe("#UpcomingExpirations", func() {
+		It("should group checks by their exception and return the upcoming expirations", func() {
+			var (
+				target          = rule.NewTarget("kind", "Pod", "name", "foo")
+				laterException  = rule.Exception{ExpiresAt: "2000-03-01", Owner: "team-foo"}
+				soonerException = rule.Exception{ExpiresAt: "2000-01-11", Reference: "https://example.com/issues/1"}
+				lapsedException = rule.Exception{ExpiresAt: "1999-12-31"}
+			)
+			rep := report.FromProviderResults([]provider.ProviderResult{
+				{
+					ProviderID:   "provider-foo",
+					ProviderName: "Provider Foo",
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "ruleset-foo",
+							RulesetName:    "Ruleset Foo",
+							RulesetVersion: "v1",
+							RuleResults: []rule.RuleResult{
+								{
+									RuleID:   "1",
+									RuleName: "Rule 1",
+									CheckResults: []rule.CheckResult{
+										laterException.CheckResult("accepted", target, time.Time{}),
+										soonerException.CheckResult("accepted", target, time.Time{}),
+										lapsedException.CheckResult("accepted", target, time.Time{}),
+										rule.Exception{Owner: "team-foo"}.CheckResult("accepted", target, time.Time{}),
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			rep.Time = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks).To(HaveLen(4))
+			expirations := rep.UpcomingExpirations()
+			Expect(expirations).To(HaveLen(2))
+			Expect(expirations[0].Check.Exception).To(Equal(&soonerException))
+			Expect(expirations[0].Days).To(Equal(10))
+			Expect(expirations[0].RuleID).To(Equal("1"))
+			Expect(expirations[1].Check.Exception).To(Equal(&laterException))
+
+			renderer, err := report.NewHTMLRenderer()
+			Expect(err).NotTo(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, rep)).To(Succeed())
+			Expect(buf.String()).To(And(
+				ContainSubstring("Upcoming Expirations (2)"),
+				ContainSubstring("<span class=\"tw-font-semibold\">2000-01-11</span> (in 10 days) Provider Foo, v1 Ruleset Foo, 1 - Rule 1: accepted; reference: https://example.com/issues/1</li>"),
+				ContainSubstring("<span>(expires at 2000-03-01, owner: team-foo)</span>"),
+			))
+		})
+	})
+
+	Describe("#Fingerprint", func() {
+		It("should not depend on the order of target keys", func() {
+			Expect(report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("kind", "Pod", "name", "foo"))).
+				To(Equal(report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("name", "foo", "kind", "Pod"))))
+		})
+
+		It("should differ for different providers, rulesets, rules and targets", func() {
+			fingerprints := []string{
+				report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("name", "foo")),
+				report.Fingerprint("provider2", "ruleset", "1", rule.NewTarget("name", "foo")),
+				report.Fingerprint("provider", "ruleset2", "1", rule.NewTarget("name", "foo")),
+				report.Fingerprint("provider", "ruleset", "2", rule.NewTarget("name", "foo")),
+				report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("name", "bar")),
+				report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("name", "foo bar")),
+				report.Fingerprint("provider", "ruleset", "1", rule.NewTarget("name", "foo", "bar", "")),
+				report.Fingerprint("provider", "ruleset", "1", nil),
+			}
+			Expect(slices.Compact(slices.Sorted(slices.Values(fingerprints)))).To(HaveLen(len(fingerprints)))
+		})
+	})
 })
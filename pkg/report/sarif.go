@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	dikiURI        = "https://github.com/gardener/diki"
+)
+
+// SARIFRenderer renders Diki reports in SARIF 2.1.0 format.
+// Each provider is rendered as a separate run, each rule as a reporting descriptor
+// and each target of a check as a result with the target as its logical location.
+type SARIFRenderer struct{}
+
+var _ Renderer = &SARIFRenderer{}
+
+// NewSARIFRenderer creates a SARIFRenderer.
+func NewSARIFRenderer() *SARIFRenderer {
+	return &SARIFRenderer{}
+}
+
+// Render writes a Diki report in SARIF format into the passed writer.
+func (r *SARIFRenderer) Render(w io.Writer, report any) error {
+	rep, ok := report.(*Report)
+	if !ok {
+		return fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLogFromReport(rep))
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool              sarifTool              `json:"tool"`
+	AutomationDetails sarifAutomationDetails `json:"automationDetails"`
+	Invocations       []sarifInvocation      `json:"invocations"`
+	Results           []sarifResult          `json:"results"`
+	Properties        map[string]any         `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	Version        string                     `json:"version,omitempty"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful bool   `json:"executionSuccessful"`
+	EndTimeUTC          string `json:"endTimeUtc,omitempty"`
+}
+
+type sarifReportingDescriptor struct {
+	ID                   string                    `json:"id"`
+	ShortDescription     sarifMessage              `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration        `json:"defaultConfiguration"`
+	Properties           sarifDescriptorProperties `json:"properties"`
+}
+
+type sarifDescriptorProperties struct {
+	Severity         rule.SeverityLevel `json:"severity,omitempty"`
+	SecuritySeverity string             `json:"security-severity,omitempty"`
+	Tags             []string           `json:"tags"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string                `json:"ruleId"`
+	RuleIndex    int                   `json:"ruleIndex"`
+	Kind         string                `json:"kind"`
+	Level        string                `json:"level"`
+	Message      sarifMessage          `json:"message"`
+	Locations    []sarifLocation       `json:"locations,omitempty"`
+	Suppressions []sarifSuppression    `json:"suppressions,omitempty"`
+	Properties   sarifResultProperties `json:"properties"`
+}
+
+type sarifResultProperties struct {
+	Status         rule.Status `json:"status"`
+	RulesetID      string      `json:"rulesetId"`
+	RulesetVersion string      `json:"rulesetVersion"`
+	Target         rule.Target `json:"target,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+func sarifLogFromReport(report *Report) sarifLog {
+	log := sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs:    make([]sarifRun, 0, len(report.Providers)),
+	}
+
+	for _, provider := range report.Providers {
+		run := sarifRun{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           "diki",
+					Version:        report.DikiVersion,
+					InformationURI: dikiURI,
+					Rules:          []sarifReportingDescriptor{},
+				},
+			},
+			AutomationDetails: sarifAutomationDetails{ID: fmt.Sprintf("diki/%s/", provider.ID)},
+			Invocations: []sarifInvocation{
+				{ExecutionSuccessful: true, EndTimeUTC: report.Time.UTC().Format(time.RFC3339)},
+			},
+			Results:    []sarifResult{},
+			Properties: sarifRunProperties(report, provider),
+		}
+
+		ruleIndices := map[string]int{}
+		for _, ruleset := range provider.Rulesets {
+			for _, r := range ruleset.Rules {
+				// rule ids are only unique within a ruleset
+				ruleID := ruleset.ID + "/" + r.ID
+				ruleIndex, ok := ruleIndices[ruleID]
+				if !ok {
+					ruleIndex = len(run.Tool.Driver.Rules)
+					ruleIndices[ruleID] = ruleIndex
+					run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifDescriptor(ruleID, ruleset.ID, r))
+				}
+
+				for _, check := range r.Checks {
+					run.Results = append(run.Results, sarifResults(ruleID, ruleIndex, ruleset, r, check)...)
+				}
+			}
+		}
+		log.Runs = append(log.Runs, run)
+	}
+	return log
+}
+
+func sarifRunProperties(report *Report, provider Provider) map[string]any {
+	properties := map[string]any{
+		"providerId":   provider.ID,
+		"providerName": provider.Name,
+	}
+	if len(provider.Metadata) > 0 {
+		properties["providerMetadata"] = provider.Metadata
+	}
+	if len(report.Metadata) > 0 {
+		properties["metadata"] = report.Metadata
+	}
+	if len(report.MinStatus) > 0 {
+		properties["minStatus"] = report.MinStatus
+	}
+	return properties
+}
+
+func sarifDescriptor(ruleID, rulesetID string, r Rule) sarifReportingDescriptor {
+	return sarifReportingDescriptor{
+		ID:                   ruleID,
+		ShortDescription:     sarifMessage{Text: r.Name},
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(r.Severity)},
+		Properties: sarifDescriptorProperties{
+			Severity:         r.Severity,
+			SecuritySeverity: sarifSecuritySeverity(r.Severity),
+			Tags:             []string{"security", rulesetID},
+		},
+	}
+}
+
+func sarifResults(ruleID string, ruleIndex int, ruleset Ruleset, r Rule, check Check) []sarifResult {
+	result := sarifResult{
+		RuleID:    ruleID,
+		RuleIndex: ruleIndex,
+		Kind:      sarifKind(check.Status),
+		Level:     sarifResultLevel(check.Status, r.Severity),
+		Message:   sarifMessage{Text: check.Message},
+		Properties: sarifResultProperties{
+			Status:         check.Status,
+			RulesetID:      ruleset.ID,
+			RulesetVersion: ruleset.Version,
+		},
+	}
+
+	if check.Status == rule.Accepted {
+		result.Suppressions = []sarifSuppression{{Kind: "external", Justification: check.Message}}
+	}
+
+	if len(check.Targets) == 0 {
+		return []sarifResult{result}
+	}
+
+	results := make([]sarifResult, 0, len(check.Targets))
+	for _, target := range check.Targets {
+		targetResult := result
+		targetResult.Locations = []sarifLocation{{LogicalLocations: []sarifLogicalLocation{sarifLogicalLocationFromTarget(target)}}}
+		targetResult.Properties.Target = target
+		results = append(results, targetResult)
+	}
+	return results
+}
+
+// sarifLogicalLocationFromTarget returns a logical location that
+// is fully qualified by all key-value pairs of the target.
+func sarifLogicalLocationFromTarget(target rule.Target) sarifLogicalLocation {
+	return sarifLogicalLocation{
+		Name:               target["name"],
+		FullyQualifiedName: targetText(target),
+		Kind:               "resource",
+	}
+}
+
+// sarifKind returns the SARIF result kind of a check status.
+func sarifKind(status rule.Status) string {
+	switch status {
+	case rule.Passed:
+		return "pass"
+	case rule.Skipped:
+		return "notApplicable"
+	case rule.Accepted, rule.Failed, rule.Warning, rule.Errored:
+		return "fail"
+	default:
+		return "open"
+	}
+}
+
+// sarifResultLevel returns the SARIF level of a check status of a rule with the given severity.
+// Only results of kind fail can have a level other than none. Warnings and errors
+// of a rule are reported with a fixed level, since they are not findings of the rule.
+func sarifResultLevel(status rule.Status, severity rule.SeverityLevel) string {
+	switch status {
+	case rule.Accepted, rule.Failed:
+		return sarifLevel(severity)
+	case rule.Warning:
+		return "warning"
+	case rule.Errored:
+		return "error"
+	default:
+		return "none"
+	}
+}
+
+// sarifLevel returns the SARIF level of findings of a rule with the given severity.
+func sarifLevel(severity rule.SeverityLevel) string {
+	switch severity {
+	case rule.SeverityLow:
+		return "note"
+	case rule.SeverityMedium:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// sarifSecuritySeverity returns the numeric security severity of a rule
+// which is used by code scanning tools to rank findings.
+func sarifSecuritySeverity(severity rule.SeverityLevel) string {
+	switch severity {
+	case rule.SeverityLow:
+		return "3.0"
+	case rule.SeverityMedium:
+		return "6.0"
+	case rule.SeverityHigh:
+		return "8.0"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("SARIFRenderer", func() {
+	var (
+		renderer *report.SARIFRenderer
+		buf      *bytes.Buffer
+		rep      *report.Report
+	)
+
+	BeforeEach(func() {
+		renderer = report.NewSARIFRenderer()
+		buf = &bytes.Buffer{}
+		rep = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "v1.0.0",
+			Providers: []report.Provider{
+				{
+					ID:       "provider-foo",
+					Name:     "Provider Foo",
+					Metadata: map[string]string{"foo": "bar"},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "pod is privileged",
+											Targets: []rule.Target{
+												rule.NewTarget("kind", "Pod", "name", "foo", "namespace", "bar"),
+												rule.NewTarget("kind", "Pod", "name", "baz", "namespace", "bar"),
+											},
+										},
+										{
+											Status:  rule.Accepted,
+											Message: "pod is accepted",
+											Targets: []rule.Target{rule.NewTarget("name", "qux")},
+										},
+									},
+								},
+								{
+									ID:       "2",
+									Name:     "Rule 2",
+									Severity: rule.SeverityLow,
+									Checks: []report.Check{
+										{
+											Status:  rule.Passed,
+											Message: "all good",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should render a report in SARIF format", func() {
+		Expect(renderer.Render(buf, rep)).To(Succeed())
+		Expect(buf.String()).To(MatchJSON(`{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "diki",
+          "version": "v1.0.0",
+          "informationUri": "https://github.com/gardener/diki",
+          "rules": [
+            {
+              "id": "ruleset-foo/1",
+              "shortDescription": {"text": "Rule 1"},
+              "defaultConfiguration": {"level": "error"},
+              "properties": {"severity": "High", "security-severity": "8.0", "tags": ["security", "ruleset-foo"]}
+            },
+            {
+              "id": "ruleset-foo/2",
+              "shortDescription": {"text": "Rule 2"},
+              "defaultConfiguration": {"level": "note"},
+              "properties": {"severity": "Low", "security-severity": "3.0", "tags": ["security", "ruleset-foo"]}
+            }
+          ]
+        }
+      },
+      "automationDetails": {"id": "diki/provider-foo/"},
+      "invocations": [{"executionSuccessful": true, "endTimeUtc": "2000-01-01T00:00:00Z"}],
+      "results": [
+        {
+          "ruleId": "ruleset-foo/1",
+          "ruleIndex": 0,
+          "kind": "fail",
+          "level": "error",
+          "message": {"text": "pod is privileged"},
+          "locations": [{"logicalLocations": [{"name": "foo", "fullyQualifiedName": "kind=Pod, name=foo, namespace=bar", "kind": "resource"}]}],
+          "properties": {"status": "Failed", "rulesetId": "ruleset-foo", "rulesetVersion": "v1", "target": {"kind": "Pod", "name": "foo", "namespace": "bar"}}
+        },
+        {
+          "ruleId": "ruleset-foo/1",
+          "ruleIndex": 0,
+          "kind": "fail",
+          "level": "error",
+          "message": {"text": "pod is privileged"},
+          "locations": [{"logicalLocations": [{"name": "baz", "fullyQualifiedName": "kind=Pod, name=baz, namespace=bar", "kind": "resource"}]}],
+          "properties": {"status": "Failed", "rulesetId": "ruleset-foo", "rulesetVersion": "v1", "target": {"kind": "Pod", "name": "baz", "namespace": "bar"}}
+        },
+        {
+          "ruleId": "ruleset-foo/1",
+          "ruleIndex": 0,
+          "kind": "fail",
+          "level": "error",
+          "message": {"text": "pod is accepted"},
+          "locations": [{"logicalLocations": [{"name": "qux", "fullyQualifiedName": "name=qux", "kind": "resource"}]}],
+          "suppressions": [{"kind": "external", "justification": "pod is accepted"}],
+          "properties": {"status": "Accepted", "rulesetId": "ruleset-foo", "rulesetVersion": "v1", "target": {"name": "qux"}}
+        },
+        {
+          "ruleId": "ruleset-foo/2",
+          "ruleIndex": 1,
+          "kind": "pass",
+          "level": "none",
+          "message": {"text": "all good"},
+          "properties": {"status": "Passed", "rulesetId": "ruleset-foo", "rulesetVersion": "v1"}
+        }
+      ],
+      "properties": {"providerId": "provider-foo", "providerName": "Provider Foo", "providerMetadata": {"foo": "bar"}}
+    }
+  ]
+}`))
+	})
+
+	DescribeTable("should map check statuses to result kinds",
+		func(status rule.Status, expectedKind, expectedLevel string) {
+			rep.Providers[0].Rulesets[0].Rules = []report.Rule{
+				{ID: "1", Name: "Rule 1", Severity: rule.SeverityMedium, Checks: []report.Check{{Status: status, Message: "foo"}}},
+			}
+			Expect(renderer.Render(buf, rep)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring(`"kind": "` + expectedKind + `",
+          "level": "` + expectedLevel + `"`))
+		},
+		Entry("Passed", rule.Passed, "pass", "none"),
+		Entry("Skipped", rule.Skipped, "notApplicable", "none"),
+		Entry("Accepted", rule.Accepted, "fail", "warning"),
+		Entry("Warning", rule.Warning, "fail", "warning"),
+		Entry("Failed", rule.Failed, "fail", "warning"),
+		Entry("Errored", rule.Errored, "fail", "error"),
+		Entry("NotImplemented", rule.NotImplemented, "open", "none"),
+	)
+
+	It("should not render merged reports", func() {
+		Expect(renderer.Render(buf, &report.MergedReport{})).To(MatchError("unsupported report type: *report.MergedReport"))
+	})
+})
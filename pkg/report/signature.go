@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// SignatureFileSuffix is appended to the path of a report to get the path of its detached signature.
+const SignatureFileSuffix = ".sig"
+
+const (
+	// SignatureAlgorithmEd25519 is the algorithm of signatures created with ed25519 keys.
+	SignatureAlgorithmEd25519 = "ed25519"
+	// SignatureAlgorithmECDSASHA256 is the algorithm of signatures created with ecdsa keys.
+	SignatureAlgorithmECDSASHA256 = "ecdsa-sha256"
+	// SignatureAlgorithmRSASHA256 is the algorithm of signatures created with rsa keys.
+	SignatureAlgorithmRSASHA256 = "rsa-pkcs1v15-sha256"
+)
+
+// Signature is a detached signature over the canonical JSON of a report.
+type Signature struct {
+	// Algorithm is the algorithm of the signature.
+	Algorithm string `json:"algorithm"`
+	// Signer is the identity of the signer. It is the subject of the
+	// signer's certificate or the fingerprint of the signer's public key.
+	Signer string `json:"signer"`
+	// PublicKey is the DER encoded PKIX public key of the signer.
+	PublicKey []byte `json:"publicKey"`
+	// Certificates are the DER encoded certificate chain of the signer, starting with the signer's certificate.
+	Certificates [][]byte `json:"certificates,omitempty"`
+	// Value is the signature value.
+	Value []byte `json:"value"`
+}
+
+// Verification is the result of the verification of a report signature.
+type Verification struct {
+	// Verified is true if the signature is valid and trusted.
+	Verified bool `json:"verified"`
+	// Signer is the identity of the signer.
+	Signer string `json:"signer,omitempty"`
+	// Error describes why the verification failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Signer signs reports with a local private key.
+type Signer struct {
+	key          crypto.Signer
+	certificates []*x509.Certificate
+}
+
+// NewSigner parses a PEM encoded private key and an optional certificate chain
+// that starts with the certificate of the key. Supported are PKCS #8, PKCS #1 and
+// SEC 1 encoded ed25519, ecdsa and rsa keys.
+func NewSigner(pemData []byte) (*Signer, error) {
+	s := &Signer{}
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			if s.key != nil {
+				return nil, errors.New("key file contains more than one private key")
+			}
+			key, err := parsePrivateKey(block)
+			if err != nil {
+				return nil, err
+			}
+			s.key = key
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+			s.certificates = append(s.certificates, certificate)
+		}
+	}
+
+	if s.key == nil {
+		return nil, errors.New("key file does not contain a PEM encoded private key")
+	}
+	if len(s.certificates) > 0 && !publicKeysEqual(s.certificates[0].PublicKey, s.key.Public()) {
+		return nil, errors.New("the first certificate does not belong to the private key")
+	}
+	return s, nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	case *rsa.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// Sign creates a detached signature over the canonical JSON of the given report data.
+func (s *Signer) Sign(data []byte) (*Signature, error) {
+	canonical, err := CanonicalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(s.key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	signature := &Signature{PublicKey: publicKey}
+	var opts crypto.SignerOpts = crypto.SHA256
+	digest := sha256.Sum256(canonical)
+	message := digest[:]
+	switch s.key.(type) {
+	case ed25519.PrivateKey:
+		signature.Algorithm = SignatureAlgorithmEd25519
+		opts, message = crypto.Hash(0), canonical
+	case *ecdsa.PrivateKey:
+		signature.Algorithm = SignatureAlgorithmECDSASHA256
+	case *rsa.PrivateKey:
+		signature.Algorithm = SignatureAlgorithmRSASHA256
+	}
+
+	if signature.Value, err = s.key.Sign(rand.Reader, message, opts); err != nil {
+		return nil, fmt.Errorf("failed to sign report: %w", err)
+	}
+
+	for _, certificate := range s.certificates {
+		signature.Certificates = append(signature.Certificates, certificate.Raw)
+	}
+	signature.Signer = signerIdentity(s.certificates, publicKey)
+	return signature, nil
+}
+
+// WriteSignedToFile writes the report in json format to filePath and
+// its detached signature to filePath with the [SignatureFileSuffix].
+func (r *Report) WriteSignedToFile(filePath string, signer *Signer) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	signature, err := signer.Sign(data)
+	if err != nil {
+		return err
+	}
+
+	signatureData, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath+SignatureFileSuffix, signatureData, 0600)
+}
+
+// Verifier verifies report signatures against trusted public keys and certificates.
+type Verifier struct {
+	publicKeys []crypto.PublicKey
+	roots      *x509.CertPool
+}
+
+// NewVerifier parses PEM encoded trusted public keys and certificates. Signatures of
+// trusted public keys are accepted as well as signatures with a certificate chain
+// that can be verified with the trusted certificates as roots.
+func NewVerifier(pemData []byte) (*Verifier, error) {
+	v := &Verifier{roots: x509.NewCertPool()}
+	numCertificates := 0
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "PUBLIC KEY":
+			publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key: %w", err)
+			}
+			v.publicKeys = append(v.publicKeys, publicKey)
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+			v.roots.AddCert(certificate)
+			numCertificates++
+		}
+	}
+
+	if len(v.publicKeys) == 0 && numCertificates == 0 {
+		return nil, errors.New("trust file does not contain PEM encoded public keys or certificates")
+	}
+	return v, nil
+}
+
+// Verify verifies the signature over the canonical JSON of the given report data.
+// It returns the identity of the signer. Certificate chains are verified at the time of
+// the report, so that reports stay verifiable after the certificates expired.
+func (v *Verifier) Verify(data []byte, signature *Signature) (string, error) {
+	canonical, err := CanonicalJSON(data)
+	if err != nil {
+		return "", err
+	}
+
+	var signed struct {
+		Time time.Time `json:"time"`
+	}
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return "", err
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(signature.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse public key of signature: %w", err)
+	}
+
+	if err := verifySignature(publicKey, signature.Algorithm, canonical, signature.Value); err != nil {
+		return "", err
+	}
+
+	certificates := make([]*x509.Certificate, 0, len(signature.Certificates))
+	for _, raw := range signature.Certificates {
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse certificate of signature: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if !v.trusts(publicKey, certificates, signed.Time) {
+		return "", errors.New("signer is not trusted")
+	}
+	return signerIdentity(certificates, signature.PublicKey), nil
+}
+
+// VerifySignature verifies the signature and returns the result as a [Verification].
+func (v *Verifier) VerifySignature(data []byte, signature *Signature) *Verification {
+	signer, err := v.Verify(data, signature)
+	if err != nil {
+		return &Verification{Signer: signature.Signer, Error: err.Error()}
+	}
+	return &Verification{Verified: true, Signer: signer}
+}
+
+// trusts returns true if the public key is trusted or the certificate chain of the
+// public key can be verified at the given time with the trusted certificates as roots.
+func (v *Verifier) trusts(publicKey crypto.PublicKey, certificates []*x509.Certificate, at time.Time) bool {
+	if slices.ContainsFunc(v.publicKeys, func(trusted crypto.PublicKey) bool {
+		return publicKeysEqual(trusted, publicKey)
+	}) {
+		return true
+	}
+
+	if len(certificates) == 0 || !publicKeysEqual(certificates[0].PublicKey, publicKey) {
+		return false
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil
+}
+
+func verifySignature(publicKey crypto.PublicKey, algorithm string, message, value []byte) error {
+	digest := sha256.Sum256(message)
+	valid := false
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		valid = algorithm == SignatureAlgorithmEd25519 && ed25519.Verify(key, message, value)
+	case *ecdsa.PublicKey:
+		valid = algorithm == SignatureAlgorithmECDSASHA256 && ecdsa.VerifyASN1(key, digest[:], value)
+	case *rsa.PublicKey:
+		valid = algorithm == SignatureAlgorithmRSASHA256 && rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], value) == nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	if !valid {
+		return errors.New("signature does not match the report")
+	}
+	return nil
+}
+
+// publicKeysEqual returns true if both keys are supported and equal.
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
+
+// signerIdentity returns the subject of the first certificate or, if there are
+// no certificates, the SHA256 fingerprint of the DER encoded public key.
+func signerIdentity(certificates []*x509.Certificate, publicKey []byte) string {
+	if len(certificates) > 0 {
+		return certificates[0].Subject.String()
+	}
+	fingerprint := sha256.Sum256(publicKey)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(fingerprint[:])
+}
+
+// CanonicalJSON returns the canonical form of JSON data. Objects keys are sorted,
+// insignificant whitespace is removed and numbers are kept as they are.
+func CanonicalJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode report: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("failed to decode report: unexpected data after the report")
+	}
+	return json.Marshal(v)
+}
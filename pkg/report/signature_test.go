@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("signature", func() {
+	var (
+		rep *report.Report
+
+		encodePrivateKey = func(key crypto.Signer) []byte {
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		}
+		encodePublicKey = func(key crypto.PublicKey) []byte {
+			der, err := x509.MarshalPKIXPublicKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		}
+		newCertificate = func(commonName string, key crypto.Signer, parent *x509.Certificate, parentKey crypto.Signer) *x509.Certificate {
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: commonName},
+				NotBefore:             rep.Time.Add(-time.Hour),
+				NotAfter:              rep.Time.Add(time.Hour),
+				KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+				BasicConstraintsValid: true,
+				IsCA:                  parent == nil,
+			}
+			if parent == nil {
+				parent, parentKey = template, key
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+			Expect(err).NotTo(HaveOccurred())
+			certificate, err := x509.ParseCertificate(der)
+			Expect(err).NotTo(HaveOccurred())
+			return certificate
+		}
+		encodeCertificate = func(certificate *x509.Certificate) []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})
+		}
+	)
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Time:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			MinStatus: rule.Passed,
+			Providers: []report.Provider{
+				{
+					ID:   "provider-foo",
+					Name: "Provider Foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{ID: "1", Name: "Rule 1", Checks: []report.Check{{Status: rule.Failed, Message: "privileged"}}},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#CanonicalJSON", func() {
+		It("should sort object keys and remove whitespace", func() {
+			canonical, err := report.CanonicalJSON([]byte(`{ "b": [1.50, {"d": 1, "c": "x"}], "a": null }`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(canonical)).To(Equal(`{"a":null,"b":[1.50,{"c":"x","d":1}]}`))
+		})
+
+		It("should return error for invalid json", func() {
+			_, err := report.CanonicalJSON([]byte(`{"a":1} {"b":2}`))
+
+			Expect(err).To(MatchError("failed to decode report: unexpected data after the report"))
+		})
+	})
+
+	Describe("#WriteSignedToFile", func() {
+		It("should write a signature that can be verified with the trusted ed25519 public key", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signer, err := report.NewSigner(encodePrivateKey(privateKey))
+			Expect(err).NotTo(HaveOccurred())
+
+			reportPath := filepath.Join(GinkgoT().TempDir(), "report.json")
+			Expect(rep.WriteSignedToFile(reportPath, signer)).To(Succeed())
+
+			data, err := os.ReadFile(reportPath)
+			Expect(err).NotTo(HaveOccurred())
+			signatureData, err := os.ReadFile(reportPath + report.SignatureFileSuffix)
+			Expect(err).NotTo(HaveOccurred())
+			signature := &report.Signature{}
+			Expect(json.Unmarshal(signatureData, signature)).To(Succeed())
+			Expect(signature.Algorithm).To(Equal(report.SignatureAlgorithmEd25519))
+
+			verifier, err := report.NewVerifier(encodePublicKey(publicKey))
+			Expect(err).NotTo(HaveOccurred())
+
+			indented := &bytes.Buffer{}
+			Expect(json.Indent(indented, data, "", "  ")).To(Succeed())
+			identity, err := verifier.Verify(indented.Bytes(), signature)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identity).To(HavePrefix("SHA256:"))
+			Expect(identity).To(Equal(signature.Signer))
+		})
+	})
+
+	Describe("#Verify", func() {
+		var (
+			caKey, key         *ecdsa.PrivateKey
+			caCert, cert       *x509.Certificate
+			data               []byte
+			newSignature       func(pemData []byte) *report.Signature
+			signerIdentity     = "CN=diki-signer"
+			untrustedPublicKey ed25519.PublicKey
+		)
+
+		BeforeEach(func() {
+			var err error
+			caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			caCert = newCertificate("diki-ca", caKey, nil, nil)
+			cert = newCertificate("diki-signer", key, caCert, caKey)
+			untrustedPublicKey, _, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err = json.Marshal(rep)
+			Expect(err).NotTo(HaveOccurred())
+
+			newSignature = func(pemData []byte) *report.Signature {
+				signer, err := report.NewSigner(pemData)
+				Expect(err).NotTo(HaveOccurred())
+				signature, err := signer.Sign(data)
+				Expect(err).NotTo(HaveOccurred())
+				return signature
+			}
+		})
+
+		It("should verify a signature with a certificate issued by a trusted certificate", func() {
+			signature := newSignature(append(encodePrivateKey(key), encodeCertificate(cert)...))
+			Expect(signature.Algorithm).To(Equal(report.SignatureAlgorithmECDSASHA256))
+
+			verifier, err := report.NewVerifier(encodeCertificate(caCert))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(verifier.VerifySignature(data, signature)).To(Equal(&report.Verification{Verified: true, Signer: signerIdentity}))
+		})
+
+		It("should not verify a signature with a certificate that is not valid at the time of the report", func() {
+			rep.Time = rep.Time.Add(2 * time.Hour)
+			var err error
+			data, err = json.Marshal(rep)
+			Expect(err).NotTo(HaveOccurred())
+
+			signature := newSignature(append(encodePrivateKey(key), encodeCertificate(cert)...))
+			verifier, err := report.NewVerifier(encodeCertificate(caCert))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(verifier.VerifySignature(data, signature)).To(Equal(&report.Verification{Signer: signerIdentity, Error: "signer is not trusted"}))
+		})
+
+		It("should not verify a signature of a modified report", func() {
+			signature := newSignature(append(encodePrivateKey(key), encodeCertificate(cert)...))
+			verifier, err := report.NewVerifier(encodeCertificate(caCert))
+			Expect(err).NotTo(HaveOccurred())
+
+			rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Status = rule.Passed
+			modified, err := json.Marshal(rep)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(verifier.VerifySignature(modified, signature)).To(Equal(&report.Verification{Signer: signerIdentity, Error: "signature does not match the report"}))
+		})
+
+		It("should not verify a signature of an untrusted signer", func() {
+			verifier, err := report.NewVerifier(encodePublicKey(untrustedPublicKey))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = verifier.Verify(data, newSignature(encodePrivateKey(key)))
+			Expect(err).To(MatchError("signer is not trusted"))
+
+			_, err = verifier.Verify(data, newSignature(append(encodePrivateKey(key), encodeCertificate(cert)...)))
+			Expect(err).To(MatchError("signer is not trusted"))
+		})
+	})
+
+	Describe("#NewSigner", func() {
+		It("should return error when the certificate does not belong to the key", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = report.NewSigner(append(encodePrivateKey(key), encodeCertificate(newCertificate("other", otherKey, nil, nil))...))
+			Expect(err).To(MatchError("the first certificate does not belong to the private key"))
+		})
+
+		It("should return error when there is no private key", func() {
+			_, err := report.NewSigner([]byte("foo"))
+			Expect(err).To(MatchError("key file does not contain a PEM encoded private key"))
+		})
+	})
+
+	Describe("HTMLRenderer", func() {
+		It("should render the verification in the footer", func() {
+			renderer, err := report.NewHTMLRenderer(report.WithVerification(&report.Verification{Verified: true, Signer: "CN=diki-signer"}))
+			Expect(err).NotTo(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, rep)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("verified, signed by CN=diki-signer"))
+
+			renderer, err = report.NewHTMLRenderer()
+			Expect(err).NotTo(HaveOccurred())
+
+			buf.Reset()
+			Expect(renderer.Render(buf, rep)).To(Succeed())
+			Expect(buf.String()).NotTo(ContainSubstring("<footer>"))
+		})
+	})
+})
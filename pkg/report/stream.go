@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// RuleResultRecord is a single rule result tagged with
+// its provider and ruleset. It is used for streaming output
+// in JSON Lines format while a Diki run is still in progress.
+type RuleResultRecord struct {
+	Time             time.Time         `json:"time"`
+	ProviderID       string            `json:"providerID"`
+	ProviderName     string            `json:"providerName"`
+	ProviderMetadata map[string]string `json:"providerMetadata,omitempty"`
+	RulesetID        string            `json:"rulesetID"`
+	RulesetName      string            `json:"rulesetName"`
+	RulesetVersion   string            `json:"rulesetVersion"`
+	TimedOut         bool              `json:"timedOut,omitempty"`
+	RuleResult       rule.RuleResult   `json:"ruleResult"`
+}
+
+// RecordsFromRulesetResult returns a [RuleResultRecord] for each rule result of a ruleset result.
+func RecordsFromRulesetResult(p provider.Provider, result ruleset.RulesetResult) []RuleResultRecord {
+	records := make([]RuleResultRecord, 0, len(result.RuleResults))
+	for _, ruleResult := range result.RuleResults {
+		records = append(records, RuleResultRecord{
+			Time:             time.Now().UTC(),
+			ProviderID:       p.ID(),
+			ProviderName:     p.Name(),
+			ProviderMetadata: p.Metadata(),
+			RulesetID:        result.RulesetID,
+			RulesetName:      result.RulesetName,
+			RulesetVersion:   result.RulesetVersion,
+			TimedOut:         slices.Contains(result.TimedOutRules, ruleResult.RuleID),
+			RuleResult:       ruleResult,
+		})
+	}
+	return records
+}
+
+// StreamWriter writes [RuleResultRecord]s in JSON Lines format.
+// It is safe for concurrent use.
+type StreamWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStreamWriter creates a StreamWriter that writes to w.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+// Write writes records as separate lines. Each line is written with a single write call.
+func (s *StreamWriter) Write(records ...RuleResultRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OpenStreamFile opens the file at the given path for appending [RuleResultRecord]s and creates it if it does not exist.
+// An incomplete last line, e.g. from an interrupted run, is removed, so that the appended records start on a new line.
+func OpenStreamFile(filePath string) (*os.File, error) {
+	file, err := os.OpenFile(filepath.Clean(filePath), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := truncateIncompleteLine(file); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to truncate incomplete line of %s: %w", filePath, err), file.Close())
+	}
+	return file, nil
+}
+
+// truncateIncompleteLine truncates the file after its last new line.
+func truncateIncompleteLine(file *os.File) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4096)
+	for end := info.Size(); end > 0; {
+		start := max(end-int64(len(buf)), 0)
+		n, err := file.ReadAt(buf[:end-start], start)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if end == info.Size() && n > 0 && buf[n-1] == '\n' {
+			return nil
+		}
+		if i := bytes.LastIndexByte(buf[:n], '\n'); i >= 0 {
+			return file.Truncate(start + int64(i) + 1)
+		}
+		end = start
+	}
+	return file.Truncate(0)
+}
+
+// ReadStream reads [RuleResultRecord]s in JSON Lines format.
+// An incomplete last line, e.g. from an interrupted run, is ignored.
+func ReadStream(r io.Reader) ([]RuleResultRecord, error) {
+	var (
+		records []RuleResultRecord
+		reader  = bufio.NewReader(r)
+		lineNum = 0
+	)
+
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return nil, readErr
+		}
+		lineNum++
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var record RuleResultRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				// the last line is incomplete when it does not end with a new line
+				if errors.Is(readErr, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("failed to unmarshal line %d: %w", lineNum, err)
+			}
+			records = append(records, record)
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+	}
+	return records, nil
+}
+
+// ProviderResultsFromRecords groups [RuleResultRecord]s into provider results.
+// If a rule has multiple records only the latest one is taken into account.
+// Providers, rulesets and rules are sorted by their identifiers.
+func ProviderResultsFromRecords(records []RuleResultRecord) []provider.ProviderResult {
+	type rulesetKey struct {
+		providerID, rulesetID, rulesetVersion string
+	}
+
+	var (
+		providerResults = map[string]*provider.ProviderResult{}
+		rulesetResults  = map[rulesetKey]*ruleset.RulesetResult{}
+		ruleResults     = map[rulesetKey]map[string]RuleResultRecord{}
+	)
+
+	for _, record := range records {
+		if _, ok := providerResults[record.ProviderID]; !ok {
+			providerResults[record.ProviderID] = &provider.ProviderResult{
+				ProviderID:   record.ProviderID,
+				ProviderName: record.ProviderName,
+				Metadata:     maps.Clone(record.ProviderMetadata),
+			}
+		}
+
+		key := rulesetKey{record.ProviderID, record.RulesetID, record.RulesetVersion}
+		if _, ok := rulesetResults[key]; !ok {
+			rulesetResults[key] = &ruleset.RulesetResult{
+				RulesetID:      record.RulesetID,
+				RulesetName:    record.RulesetName,
+				RulesetVersion: record.RulesetVersion,
+			}
+			ruleResults[key] = map[string]RuleResultRecord{}
+		}
+
+		if existing, ok := ruleResults[key][record.RuleResult.RuleID]; !ok || !record.Time.Before(existing.Time) {
+			ruleResults[key][record.RuleResult.RuleID] = record
+		}
+	}
+
+	for _, key := range slices.SortedFunc(maps.Keys(rulesetResults), func(a, b rulesetKey) int {
+		return cmp.Or(cmp.Compare(a.rulesetID, b.rulesetID), cmp.Compare(a.rulesetVersion, b.rulesetVersion))
+	}) {
+		rulesetResult := rulesetResults[key]
+		for _, ruleID := range slices.Sorted(maps.Keys(ruleResults[key])) {
+			record := ruleResults[key][ruleID]
+			rulesetResult.RuleResults = append(rulesetResult.RuleResults, record.RuleResult)
+			if record.TimedOut {
+				rulesetResult.TimedOutRules = append(rulesetResult.TimedOutRules, ruleID)
+			}
+		}
+
+		providerResult := providerResults[key.providerID]
+		providerResult.RulesetResults = append(providerResult.RulesetResults, *rulesetResult)
+	}
+
+	results := make([]provider.ProviderResult, 0, len(providerResults))
+	for _, providerID := range slices.Sorted(maps.Keys(providerResults)) {
+		results = append(results, *providerResults[providerID])
+	}
+	return results
+}
+
+// FromRecords returns a Diki report from [RuleResultRecord]s.
+// The time of the report is the time of the latest record.
+func FromRecords(records []RuleResultRecord, options ...ReportOption) *Report {
+	report := FromProviderResults(ProviderResultsFromRecords(records), options...)
+	if len(records) > 0 {
+		latest := slices.MaxFunc(records, func(a, b RuleResultRecord) int {
+			return a.Time.Compare(b.Time)
+		})
+		report.Time = latest.Time
+	}
+	return report
+}
+
+// ResumeState holds the latest rule results of a previous run
+// and is used to resume the run without running these rules again.
+type ResumeState struct {
+	ruleResults map[resumeKey]rule.RuleResult
+}
+
+type resumeKey struct {
+	providerID, rulesetID, rulesetVersion, ruleID string
+}
+
+// NewResumeState creates a ResumeState from [RuleResultRecord]s.
+// If a rule has multiple records only the latest one is taken into account.
+// Rules whose latest record is timed out or contains errored checks are not resumed.
+func NewResumeState(records []RuleResultRecord) *ResumeState {
+	state := &ResumeState{ruleResults: map[resumeKey]rule.RuleResult{}}
+	for _, providerResult := range ProviderResultsFromRecords(records) {
+		for _, rulesetResult := range providerResult.RulesetResults {
+			for _, ruleResult := range rulesetResult.RuleResults {
+				if slices.Contains(rulesetResult.TimedOutRules, ruleResult.RuleID) || hasErroredChecks(ruleResult) {
+					continue
+				}
+				key := resumeKey{providerResult.ProviderID, rulesetResult.RulesetID, rulesetResult.RulesetVersion, ruleResult.RuleID}
+				state.ruleResults[key] = ruleResult
+			}
+		}
+	}
+	return state
+}
+
+// hasErroredChecks returns true if the rule result contains a check with status Errored.
+func hasErroredChecks(ruleResult rule.RuleResult) bool {
+	return slices.ContainsFunc(ruleResult.CheckResults, func(c rule.CheckResult) bool {
+		return c.Status == rule.Errored
+	})
+}
+
+// RuleResults returns the [ruleset.ResumedRuleResults] of the provider with the given id.
+func (s *ResumeState) RuleResults(providerID string) ruleset.ResumedRuleResults {
+	return func(rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, bool) {
+		ruleResult, ok := s.ruleResults[resumeKey{providerID, rulesetID, rulesetVersion, ruleID}]
+		return ruleResult, ok
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var _ = Describe("stream", func() {
+	var (
+		time1   = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		time2   = time.Date(2000, time.January, 1, 1, 0, 0, 0, time.UTC)
+		record  func(t time.Time, providerID, rulesetID, ruleID string, status rule.Status) report.RuleResultRecord
+		records []report.RuleResultRecord
+	)
+
+	BeforeEach(func() {
+		record = func(t time.Time, providerID, rulesetID, ruleID string, status rule.Status) report.RuleResultRecord {
+			return report.RuleResultRecord{
+				Time:             t,
+				ProviderID:       providerID,
+				ProviderName:     "Provider " + providerID,
+				ProviderMetadata: map[string]string{"foo": "bar"},
+				RulesetID:        rulesetID,
+				RulesetName:      "Ruleset " + rulesetID,
+				RulesetVersion:   "v1",
+				RuleResult: rule.RuleResult{
+					RuleID:       ruleID,
+					RuleName:     "Rule " + ruleID,
+					Severity:     rule.SeverityHigh,
+					CheckResults: []rule.CheckResult{{Status: status, Message: "foo", Target: rule.NewTarget("name", "bar")}},
+				},
+			}
+		}
+		records = []report.RuleResultRecord{
+			record(time1, "p2", "rs1", "1", rule.Passed),
+			record(time1, "p1", "rs2", "2", rule.Errored),
+			record(time1, "p1", "rs1", "1", rule.Failed),
+			record(time2, "p1", "rs2", "2", rule.Passed),
+		}
+	})
+
+	Describe("#StreamWriter", func() {
+		It("should write records that can be read again", func() {
+			buf := &bytes.Buffer{}
+			sw := report.NewStreamWriter(buf)
+
+			Expect(sw.Write(records[:2]...)).To(Succeed())
+			Expect(sw.Write(records[2:]...)).To(Succeed())
+			Expect(strings.Count(buf.String(), "\n")).To(Equal(4))
+
+			readRecords, err := report.ReadStream(buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readRecords).To(Equal(records))
+		})
+	})
+
+	Describe("#ReadStream", func() {
+		It("should ignore an incomplete last line", func() {
+			buf := &bytes.Buffer{}
+			Expect(report.NewStreamWriter(buf).Write(records...)).To(Succeed())
+			data := buf.Bytes()
+
+			readRecords, err := report.ReadStream(bytes.NewReader(data[:len(data)-10]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readRecords).To(Equal(records[:3]))
+		})
+
+		It("should read records appended to a stream file of an interrupted run", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), "stream.jsonl")
+			buf := &bytes.Buffer{}
+			Expect(report.NewStreamWriter(buf).Write(records[:3]...)).To(Succeed())
+			data := buf.Bytes()
+			Expect(os.WriteFile(filePath, data[:len(data)-10], 0600)).To(Succeed())
+
+			file, err := report.OpenStreamFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.NewStreamWriter(file).Write(records[3])).To(Succeed())
+			Expect(file.Close()).To(Succeed())
+
+			file, err = os.Open(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			readRecords, err := report.ReadStream(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readRecords).To(Equal([]report.RuleResultRecord{records[0], records[1], records[3]}))
+
+			_, ok := report.NewResumeState(readRecords).RuleResults("p1")("rs2", "v1", "2")
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should return an error for invalid lines that are not last", func() {
+			_, err := report.ReadStream(strings.NewReader("{\n{}\n"))
+			Expect(err).To(MatchError(ContainSubstring("failed to unmarshal line 1")))
+		})
+	})
+
+	Describe("#ProviderResultsFromRecords", func() {
+		It("should group records by provider and ruleset and keep the latest rule result", func() {
+			timedOut := record(time2, "p2", "rs1", "2", rule.Errored)
+			timedOut.TimedOut = true
+			records = append(records, timedOut)
+
+			results := report.ProviderResultsFromRecords(records)
+
+			Expect(results).To(Equal([]provider.ProviderResult{
+				{
+					ProviderID:   "p1",
+					ProviderName: "Provider p1",
+					Metadata:     map[string]string{"foo": "bar"},
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "rs1",
+							RulesetName:    "Ruleset rs1",
+							RulesetVersion: "v1",
+							RuleResults:    []rule.RuleResult{records[2].RuleResult},
+						},
+						{
+							RulesetID:      "rs2",
+							RulesetName:    "Ruleset rs2",
+							RulesetVersion: "v1",
+							RuleResults:    []rule.RuleResult{records[3].RuleResult},
+						},
+					},
+				},
+				{
+					ProviderID:   "p2",
+					ProviderName: "Provider p2",
+					Metadata:     map[string]string{"foo": "bar"},
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "rs1",
+							RulesetName:    "Ruleset rs1",
+							RulesetVersion: "v1",
+							RuleResults:    []rule.RuleResult{records[0].RuleResult, timedOut.RuleResult},
+							TimedOutRules:  []string{"2"},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#FromRecords", func() {
+		It("should create a report with the time of the latest record", func() {
+			rep := report.FromRecords(records, report.MinStatus(rule.Failed))
+
+			Expect(rep.Time).To(Equal(time2))
+			Expect(rep.MinStatus).To(Equal(rule.Failed))
+			Expect(rep.Providers).To(HaveLen(2))
+		})
+
+		It("should record the rule filter only when it is not empty", func() {
+			rep := report.FromRecords(records, report.RuleFilter(ruleset.RuleFilter{}))
+			Expect(rep.RuleFilter).To(BeNil())
+
+			rep = report.FromRecords(records, report.RuleFilter(ruleset.RuleFilter{MinSeverity: rule.SeverityHigh}))
+			Expect(rep.RuleFilter).To(Equal(&ruleset.RuleFilter{MinSeverity: rule.SeverityHigh}))
+		})
+	})
+
+	Describe("#ResumeState", func() {
+		It("should return the latest rule results of a provider which did not time out", func() {
+			timedOut := record(time2, "p2", "rs1", "2", rule.Errored)
+			timedOut.TimedOut = true
+			records = append(records, record(time1, "p2", "rs1", "2", rule.Passed), timedOut)
+
+			state := report.NewResumeState(records)
+
+			ruleResult, ok := state.RuleResults("p1")("rs2", "v1", "2")
+			Expect(ok).To(BeTrue())
+			Expect(ruleResult).To(Equal(records[3].RuleResult))
+
+			_, ok = state.RuleResults("p1")("rs2", "v2", "2")
+			Expect(ok).To(BeFalse())
+
+			_, ok = state.RuleResults("p2")("rs1", "v1", "2")
+			Expect(ok).To(BeFalse())
+
+			ruleResult, ok = state.RuleResults("p2")("rs1", "v1", "1")
+			Expect(ok).To(BeTrue())
+			Expect(ruleResult).To(Equal(records[0].RuleResult))
+		})
+
+		It("should not return rule results with errored checks", func() {
+			errored := record(time2, "p1", "rs1", "1", rule.Failed)
+			errored.RuleResult.CheckResults = append(errored.RuleResult.CheckResults, rule.ErroredCheckResult("foo", nil))
+			records = append(records, errored)
+
+			state := report.NewResumeState(records)
+
+			_, ok := state.RuleResults("p1")("rs1", "v1", "1")
+			Expect(ok).To(BeFalse())
+
+			_, ok = state.RuleResults("p1")("rs2", "v1", "2")
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	metadataColumnPrefix   = "Provider Metadata: "
+	distinctByColumnPrefix = "Distinct By: "
+	targetColumnPrefix     = "Target: "
+)
+
+// CSVRenderer renders Diki reports in CSV format with one row per check target.
+type CSVRenderer struct{}
+
+var _ Renderer = &CSVRenderer{}
+
+// NewCSVRenderer creates a CSVRenderer.
+func NewCSVRenderer() *CSVRenderer {
+	return &CSVRenderer{}
+}
+
+// Render writes a Diki report in CSV format into the passed writer.
+func (r *CSVRenderer) Render(w io.Writer, report any) error {
+	rows, err := tableFromReport(report)
+	if err != nil {
+		return err
+	}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.WriteAll(rows); err != nil {
+		return err
+	}
+	return csvWriter.Error()
+}
+
+// XLSXRenderer renders Diki reports as an Office Open XML spreadsheet with one row per check target.
+type XLSXRenderer struct{}
+
+var _ Renderer = &XLSXRenderer{}
+
+// NewXLSXRenderer creates a XLSXRenderer.
+func NewXLSXRenderer() *XLSXRenderer {
+	return &XLSXRenderer{}
+}
+
+// Render writes a Diki report in xlsx format into the passed writer.
+func (r *XLSXRenderer) Render(w io.Writer, report any) error {
+	rows, err := tableFromReport(report)
+	if err != nil {
+		return err
+	}
+
+	zipWriter := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/worksheets/sheet1.xml", xlsxSheet(rows)},
+	}
+	for _, file := range files {
+		fileWriter, err := zipWriter.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fileWriter, file.content); err != nil {
+			return err
+		}
+	}
+	return zipWriter.Close()
+}
+
+// tableRow is a single row of a tabular report.
+type tableRow struct {
+	providerID, providerName string
+	metadata                 map[string]string
+	distinctBy, distinctVal  string
+	rulesetID, version       string
+	ruleID, ruleName         string
+	severity                 rule.SeverityLevel
+	status                   rule.Status
+	message                  string
+	target                   rule.Target
+}
+
+// tableFromReport returns a header and one row for each target of each check of a report.
+// Checks without targets are returned as a single row.
+func tableFromReport(report any) ([][]string, error) {
+	var rows []tableRow
+	switch rep := report.(type) {
+	case *Report:
+		rows = tableRowsFromReport(rep)
+	case *MergedReport:
+		rows = tableRowsFromMergedReport(rep)
+	default:
+		return nil, fmt.Errorf("unsupported report type: %T", report)
+	}
+
+	var (
+		metadataKeys   = map[string]struct{}{}
+		distinctByKeys = map[string]struct{}{}
+		targetKeys     = map[string]struct{}{}
+	)
+	for _, row := range rows {
+		for key := range row.metadata {
+			metadataKeys[key] = struct{}{}
+		}
+		if len(row.distinctBy) > 0 {
+			distinctByKeys[row.distinctBy] = struct{}{}
+		}
+		for key := range row.target {
+			targetKeys[key] = struct{}{}
+		}
+	}
+
+	var (
+		sortedMetadataKeys   = slices.Sorted(maps.Keys(metadataKeys))
+		sortedDistinctByKeys = slices.Sorted(maps.Keys(distinctByKeys))
+		sortedTargetKeys     = slices.Sorted(maps.Keys(targetKeys))
+		header               = []string{"Provider ID", "Provider Name"}
+	)
+	for _, key := range sortedMetadataKeys {
+		header = append(header, metadataColumnPrefix+key)
+	}
+	for _, key := range sortedDistinctByKeys {
+		header = append(header, distinctByColumnPrefix+key)
+	}
+	header = append(header, "Ruleset ID", "Ruleset Version", "Rule ID", "Rule Name", "Severity", "Status", "Message")
+	for _, key := range sortedTargetKeys {
+		header = append(header, targetColumnPrefix+key)
+	}
+
+	table := make([][]string, 0, len(rows)+1)
+	table = append(table, header)
+	for _, row := range rows {
+		record := make([]string, 0, len(header))
+		record = append(record, row.providerID, row.providerName)
+		for _, key := range sortedMetadataKeys {
+			record = append(record, row.metadata[key])
+		}
+		for _, key := range sortedDistinctByKeys {
+			value := ""
+			if row.distinctBy == key {
+				value = row.distinctVal
+			}
+			record = append(record, value)
+		}
+		record = append(record, row.rulesetID, row.version, row.ruleID, row.ruleName, string(row.severity), string(row.status), row.message)
+		for _, key := range sortedTargetKeys {
+			record = append(record, row.target[key])
+		}
+		table = append(table, record)
+	}
+	return table, nil
+}
+
+func tableRowsFromReport(report *Report) []tableRow {
+	var rows []tableRow
+	for _, provider := range report.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, r := range ruleset.Rules {
+				for _, check := range r.Checks {
+					row := tableRow{
+						providerID:   provider.ID,
+						providerName: provider.Name,
+						metadata:     provider.Metadata,
+						rulesetID:    ruleset.ID,
+						version:      ruleset.Version,
+						ruleID:       r.ID,
+						ruleName:     r.Name,
+						severity:     r.Severity,
+						status:       check.Status,
+						message:      check.Message,
+					}
+					rows = append(rows, rowsWithTargets(row, check.Targets)...)
+				}
+			}
+		}
+	}
+	return rows
+}
+
+func tableRowsFromMergedReport(report *MergedReport) []tableRow {
+	var rows []tableRow
+	for _, provider := range report.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, r := range ruleset.Rules {
+				for _, check := range r.Checks {
+					for _, distinctVal := range sortedKeys(check.ReportsTargets) {
+						row := tableRow{
+							providerID:   provider.ID,
+							providerName: provider.Name,
+							metadata:     provider.Metadata[distinctVal],
+							distinctBy:   provider.DistinctBy,
+							distinctVal:  distinctVal,
+							rulesetID:    ruleset.ID,
+							version:      ruleset.Version,
+							ruleID:       r.ID,
+							ruleName:     r.Name,
+							severity:     r.Severity,
+							status:       check.Status,
+							message:      check.Message,
+						}
+						rows = append(rows, rowsWithTargets(row, check.ReportsTargets[distinctVal])...)
+					}
+				}
+			}
+		}
+	}
+	return rows
+}
+
+func rowsWithTargets(row tableRow, targets []rule.Target) []tableRow {
+	if len(targets) == 0 {
+		return []tableRow{row}
+	}
+
+	rows := make([]tableRow, 0, len(targets))
+	for _, target := range targets {
+		targetRow := row
+		targetRow.target = target
+		rows = append(rows, targetRow)
+	}
+	return rows
+}
+
+const (
+	xlsxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Diki Report" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+)
+
+// xlsxSheet returns a worksheet with the given rows as inline string cells.
+func xlsxSheet(rows [][]string) string {
+	var sheet strings.Builder
+	sheet.WriteString(xml.Header)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&sheet, `<row r="%d">`, i+1)
+		for j, value := range row {
+			fmt.Fprintf(&sheet, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, xlsxColumn(j), i+1)
+			// writing into a strings.Builder does not fail
+			_ = xml.EscapeText(&sheet, []byte(value))
+			sheet.WriteString(`</t></is></c>`)
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+	return sheet.String()
+}
+
+// xlsxColumn returns the name of the column with the given zero-based index, e.g. "A", "Z", "AA".
+func xlsxColumn(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
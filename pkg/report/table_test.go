@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("table renderers", func() {
+	var (
+		buf          *bytes.Buffer
+		simpleReport *report.Report
+	)
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+		simpleReport = &report.Report{
+			Time:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DikiVersion: "v1.0.0",
+			Providers: []report.Provider{
+				{
+					ID:       "provider-foo",
+					Name:     "Provider Foo",
+					Metadata: map[string]string{"id": "foo", "region": "eu"},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Name:    "Ruleset Foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "Rule 1",
+									Severity: rule.SeverityHigh,
+									Checks: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "pod is privileged",
+											Targets: []rule.Target{
+												rule.NewTarget("kind", "Pod", "name", "foo"),
+												rule.NewTarget("kind", "Pod", "name", "bar", "namespace", "baz"),
+											},
+										},
+									},
+								},
+								{
+									ID:     "2",
+									Name:   "Rule 2, with comma",
+									Checks: []report.Check{{Status: rule.Passed, Message: "all \"good\""}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("CSVRenderer", func() {
+		It("should render one row per check target", func() {
+			Expect(report.NewCSVRenderer().Render(buf, simpleReport)).To(Succeed())
+			Expect(buf.String()).To(Equal(`Provider ID,Provider Name,Provider Metadata: id,Provider Metadata: region,Ruleset ID,Ruleset Version,Rule ID,Rule Name,Severity,Status,Message,Target: kind,Target: name,Target: namespace
+provider-foo,Provider Foo,foo,eu,ruleset-foo,v1,1,Rule 1,High,Failed,pod is privileged,Pod,foo,
+provider-foo,Provider Foo,foo,eu,ruleset-foo,v1,1,Rule 1,High,Failed,pod is privileged,Pod,bar,baz
+provider-foo,Provider Foo,foo,eu,ruleset-foo,v1,2,"Rule 2, with comma",,Passed,"all ""good""",,,
+`))
+		})
+
+		It("should render merged reports with a column for the distinct by attribute", func() {
+			otherReport := *simpleReport
+			otherReport.Providers = []report.Provider{simpleReport.Providers[0]}
+			otherReport.Providers[0].Metadata = map[string]string{"id": "bar", "region": "us"}
+			otherReport.Providers[0].Rulesets = []report.Ruleset{
+				{
+					ID:      "ruleset-foo",
+					Version: "v1",
+					Rules:   []report.Rule{{ID: "2", Name: "Rule 2, with comma", Checks: []report.Check{{Status: rule.Failed, Message: "bad"}}}},
+				},
+			}
+
+			mergedReport, err := report.MergeReport([]*report.Report{simpleReport, &otherReport}, map[string]string{"provider-foo": "id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(report.NewCSVRenderer().Render(buf, mergedReport)).To(Succeed())
+			Expect(buf.String()).To(Equal(`Provider ID,Provider Name,Provider Metadata: id,Provider Metadata: region,Provider Metadata: time,Distinct By: id,Ruleset ID,Ruleset Version,Rule ID,Rule Name,Severity,Status,Message,Target: kind,Target: name,Target: namespace
+provider-foo,Provider Foo,foo,eu,01-01-2000 00:00:00,foo,ruleset-foo,v1,1,Rule 1,High,Failed,pod is privileged,Pod,foo,
+provider-foo,Provider Foo,foo,eu,01-01-2000 00:00:00,foo,ruleset-foo,v1,1,Rule 1,High,Failed,pod is privileged,Pod,bar,baz
+provider-foo,Provider Foo,foo,eu,01-01-2000 00:00:00,foo,ruleset-foo,v1,2,"Rule 2, with comma",,Passed,"all ""good""",,,
+provider-foo,Provider Foo,bar,us,01-01-2000 00:00:00,bar,ruleset-foo,v1,2,"Rule 2, with comma",,Failed,bad,,,
+`))
+		})
+
+		It("should not render unknown report types", func() {
+			Expect(report.NewCSVRenderer().Render(buf, &report.DifferenceReportsWrapper{})).To(MatchError("unsupported report type: *report.DifferenceReportsWrapper"))
+		})
+	})
+
+	Describe("XLSXRenderer", func() {
+		It("should render a spreadsheet with one row per check target", func() {
+			Expect(report.NewXLSXRenderer().Render(buf, simpleReport)).To(Succeed())
+
+			zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{}
+			for _, file := range zipReader.File {
+				fileReader, err := file.Open()
+				Expect(err).NotTo(HaveOccurred())
+				content, err := io.ReadAll(fileReader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fileReader.Close()).To(Succeed())
+				files[file.Name] = string(content)
+			}
+
+			Expect(files).To(HaveKey("[Content_Types].xml"))
+			Expect(files).To(HaveKey("xl/workbook.xml"))
+			Expect(files).To(HaveKeyWithValue("xl/worksheets/sheet1.xml", And(
+				ContainSubstring(`<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">Provider ID</t></is></c>`),
+				ContainSubstring(`<c r="N1" t="inlineStr"><is><t xml:space="preserve">Target: namespace</t></is></c></row>`),
+				ContainSubstring(`<c r="K4" t="inlineStr"><is><t xml:space="preserve">all &#34;good&#34;</t></is></c>`),
+				Not(ContainSubstring(`<row r="5">`)),
+			)))
+		})
+	})
+})
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// TrendReport contains the change of the results of multiple Diki runs over time.
+type TrendReport struct {
+	// Times are the times of the runs in ascending order.
+	Times     []time.Time    `json:"times"`
+	MinStatus rule.Status    `json:"minStatus,omitempty"`
+	Rulesets  []RulesetTrend `json:"rulesets"`
+	Findings  []FindingTrend `json:"findings"`
+}
+
+// RulesetTrend contains the number of checks per status of a ruleset in each run.
+// Checks with multiple targets are counted once per target.
+type RulesetTrend struct {
+	ProviderID   string `json:"providerID"`
+	ProviderName string `json:"providerName"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	// Counts contains the number of checks per status for each run.
+	// The counts of runs that did not include the ruleset are nil.
+	Counts []map[rule.Status]int `json:"counts"`
+	Rules  []RuleTrend           `json:"rules"`
+}
+
+// RuleTrend contains the number of checks per status of a rule in each run.
+type RuleTrend struct {
+	ID       string             `json:"id"`
+	Name     string             `json:"name"`
+	Severity rule.SeverityLevel `json:"severity,omitempty"`
+	// Counts contains the number of checks per status for each run.
+	// The counts of runs that did not include the rule are nil.
+	Counts []map[rule.Status]int `json:"counts"`
+}
+
+// FindingTrend contains when a finding was first seen and when it was resolved.
+// Findings are checks with status Warning, Failed or Errored and are
+// identified by their provider, ruleset, rule, message and target.
+type FindingTrend struct {
+	ProviderID string             `json:"providerID"`
+	RulesetID  string             `json:"rulesetID"`
+	RuleID     string             `json:"ruleID"`
+	RuleName   string             `json:"ruleName"`
+	Severity   rule.SeverityLevel `json:"severity,omitempty"`
+	Message    string             `json:"message"`
+	Target     rule.Target        `json:"target,omitempty"`
+	// Status is the status of the finding in the last run it was seen in.
+	Status    rule.Status `json:"status"`
+	FirstSeen time.Time   `json:"firstSeen"`
+	LastSeen  time.Time   `json:"lastSeen"`
+	// Resolved is the time of the first run after the finding was last seen that contains
+	// a result of its rule. It is nil if no such run exists, e.g. if the finding is present
+	// in the last run or the rule was not run, filtered or timed out in later runs.
+	Resolved *time.Time `json:"resolved,omitempty"`
+}
+
+type rulesetTrendKey struct {
+	providerID, id, version string
+}
+
+type findingTrendKey struct {
+	providerID, rulesetID, ruleID, message, target string
+}
+
+type ruleTrendKey struct {
+	providerID, rulesetID, ruleID string
+}
+
+// CreateTrend creates the trend of multiple reports of the same cluster.
+// The reports are ordered by their time. The keys of identityAttributes are provider IDs
+// and the values are metadata attributes that identify the cluster of the provider.
+// Reports whose providers have different values of these attributes are rejected.
+func CreateTrend(reports []*Report, identityAttributes map[string]string) (*TrendReport, error) {
+	if len(reports) == 0 {
+		return nil, errors.New("zero reports provided for trend")
+	}
+
+	sortedReports := slices.Clone(reports)
+	slices.SortStableFunc(sortedReports, func(a, b *Report) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	trend := &TrendReport{
+		Times:     make([]time.Time, 0, len(sortedReports)),
+		MinStatus: sortedReports[0].MinStatus,
+		Rulesets:  []RulesetTrend{},
+		Findings:  []FindingTrend{},
+	}
+
+	var (
+		rulesetIndices = map[rulesetTrendKey]int{}
+		findingIndices = map[findingTrendKey]int{}
+		lastSeenRun    = map[findingTrendKey]int{}
+		ranRules       = make([]map[ruleTrendKey]struct{}, len(sortedReports))
+		identities     = map[string]string{}
+	)
+	for run, report := range sortedReports {
+		if report.MinStatus != trend.MinStatus {
+			return nil, errors.New("reports must have equal minStatus in order to create a trend")
+		}
+		trend.Times = append(trend.Times, report.Time)
+		ranRules[run] = map[ruleTrendKey]struct{}{}
+
+		for _, provider := range report.Providers {
+			if attribute, ok := identityAttributes[provider.ID]; ok {
+				identity, seen := identities[provider.ID]
+				if seen && identity != provider.Metadata[attribute] {
+					return nil, fmt.Errorf("reports must have equal %s metadata of provider %s in order to create a trend", attribute, provider.ID)
+				}
+				identities[provider.ID] = provider.Metadata[attribute]
+			}
+
+			for _, ruleset := range provider.Rulesets {
+				key := rulesetTrendKey{provider.ID, ruleset.ID, ruleset.Version}
+				idx, ok := rulesetIndices[key]
+				if !ok {
+					idx = len(trend.Rulesets)
+					rulesetIndices[key] = idx
+					trend.Rulesets = append(trend.Rulesets, RulesetTrend{
+						ProviderID: provider.ID,
+						ID:         ruleset.ID,
+						Version:    ruleset.Version,
+						Counts:     make([]map[rule.Status]int, len(sortedReports)),
+					})
+				}
+
+				// names are taken from the latest run
+				rulesetTrend := &trend.Rulesets[idx]
+				rulesetTrend.ProviderName, rulesetTrend.Name = provider.Name, ruleset.Name
+				rulesetTrend.Counts[run] = map[rule.Status]int{}
+				for _, r := range ruleset.Rules {
+					if !slices.Contains(ruleset.TimedOutRules, r.ID) {
+						ranRules[run][ruleTrendKey{provider.ID, ruleset.ID, r.ID}] = struct{}{}
+					}
+
+					ruleTrend := rulesetTrend.ruleTrend(r, len(sortedReports))
+					ruleTrend.Counts[run] = map[rule.Status]int{}
+					for _, check := range r.Checks {
+						numTargets := max(len(check.Targets), 1)
+						ruleTrend.Counts[run][check.Status] += numTargets
+						rulesetTrend.Counts[run][check.Status] += numTargets
+
+						if !isFinding(check.Status) {
+							continue
+						}
+						for _, target := range targetsOrEmpty(check.Targets) {
+							findingKey := findingTrendKey{provider.ID, ruleset.ID, r.ID, check.Message, targetText(target)}
+							findingIdx, ok := findingIndices[findingKey]
+							if !ok {
+								findingIdx = len(trend.Findings)
+								findingIndices[findingKey] = findingIdx
+								trend.Findings = append(trend.Findings, FindingTrend{
+									ProviderID: provider.ID,
+									RulesetID:  ruleset.ID,
+									RuleID:     r.ID,
+									Message:    check.Message,
+									Target:     target,
+									FirstSeen:  report.Time,
+								})
+							}
+
+							finding := &trend.Findings[findingIdx]
+							finding.RuleName, finding.Severity, finding.Status = r.Name, r.Severity, check.Status
+							finding.LastSeen = report.Time
+							lastSeenRun[findingKey] = run
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// findings are only resolved by a later run of their rule, so that
+	// they are not resolved by runs that did not include the rule
+	for key, run := range lastSeenRun {
+		for laterRun := run + 1; laterRun < len(trend.Times); laterRun++ {
+			if _, ok := ranRules[laterRun][ruleTrendKey{key.providerID, key.rulesetID, key.ruleID}]; ok {
+				resolved := trend.Times[laterRun]
+				trend.Findings[findingIndices[key]].Resolved = &resolved
+				break
+			}
+		}
+	}
+
+	slices.SortFunc(trend.Rulesets, func(a, b RulesetTrend) int {
+		return cmp.Or(cmp.Compare(a.ProviderID, b.ProviderID), cmp.Compare(a.ID, b.ID), cmp.Compare(a.Version, b.Version))
+	})
+	for _, rulesetTrend := range trend.Rulesets {
+		slices.SortFunc(rulesetTrend.Rules, func(a, b RuleTrend) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+	}
+	slices.SortFunc(trend.Findings, func(a, b FindingTrend) int {
+		return cmp.Or(
+			cmp.Compare(a.ProviderID, b.ProviderID),
+			cmp.Compare(a.RulesetID, b.RulesetID),
+			cmp.Compare(a.RuleID, b.RuleID),
+			cmp.Compare(a.Message, b.Message),
+			cmp.Compare(targetText(a.Target), targetText(b.Target)),
+		)
+	})
+	return trend, nil
+}
+
+const (
+	trendChartHeight    = 120
+	trendChartBarWidth  = 16
+	trendChartBarMargin = 8
+)
+
+// trendChart contains the geometry of a stacked bar chart with one bar per run.
+type trendChart struct {
+	Width, Height, BarWidth int
+	Bars                    []trendChartBar
+}
+
+type trendChartBar struct {
+	X        int
+	Segments []trendChartSegment
+}
+
+type trendChartSegment struct {
+	Y, Height int
+	Color     string
+	Title     string
+}
+
+// newTrendChart returns a stacked bar chart of the number of checks per status of each run.
+func newTrendChart(times []time.Time, counts []map[rule.Status]int) trendChart {
+	maxTotal := 0
+	for _, runCounts := range counts {
+		total := 0
+		for _, num := range runCounts {
+			total += num
+		}
+		maxTotal = max(maxTotal, total)
+	}
+
+	chart := trendChart{
+		Width:    len(counts) * (trendChartBarWidth + trendChartBarMargin),
+		Height:   trendChartHeight,
+		BarWidth: trendChartBarWidth,
+	}
+	for run, runCounts := range counts {
+		bar := trendChartBar{X: run * (trendChartBarWidth + trendChartBarMargin)}
+		y := trendChartHeight
+		for _, status := range rule.Statuses() {
+			num := runCounts[status]
+			if num == 0 {
+				continue
+			}
+			height := max(num*trendChartHeight/maxTotal, 1)
+			y -= height
+			bar.Segments = append(bar.Segments, trendChartSegment{
+				Y:      y,
+				Height: height,
+				Color:  statusColor(status),
+				Title:  fmt.Sprintf("%s: %dx %s", times[run].Format(time.DateOnly), num, status),
+			})
+		}
+		chart.Bars = append(chart.Bars, bar)
+	}
+	return chart
+}
+
+// statusColor returns the color of a status in charts.
+func statusColor(status rule.Status) string {
+	switch status {
+	case rule.Passed:
+		return "#22c55e"
+	case rule.Skipped:
+		return "#3b82f6"
+	case rule.Accepted:
+		return "#93c5fd"
+	case rule.Warning:
+		return "#f97316"
+	case rule.Failed:
+		return "#ef4444"
+	case rule.Errored:
+		return "#991b1b"
+	default:
+		return "#9ca3af"
+	}
+}
+
+// openFindings returns the findings that are present in the last run.
+func openFindings(findings []FindingTrend) []FindingTrend {
+	var result []FindingTrend
+	for _, finding := range findings {
+		if finding.Resolved == nil {
+			result = append(result, finding)
+		}
+	}
+	return result
+}
+
+// resolvedFindings returns the findings that are not present in the last run.
+func resolvedFindings(findings []FindingTrend) []FindingTrend {
+	var result []FindingTrend
+	for _, finding := range findings {
+		if finding.Resolved != nil {
+			result = append(result, finding)
+		}
+	}
+	return result
+}
+
+// ruleTrend returns the trend of a rule and adds it to the ruleset trend if it is not present yet.
+func (rt *RulesetTrend) ruleTrend(r Rule, numRuns int) *RuleTrend {
+	idx := slices.IndexFunc(rt.Rules, func(ruleTrend RuleTrend) bool {
+		return ruleTrend.ID == r.ID
+	})
+	if idx < 0 {
+		idx = len(rt.Rules)
+		rt.Rules = append(rt.Rules, RuleTrend{ID: r.ID, Counts: make([]map[rule.Status]int, numRuns)})
+	}
+	rt.Rules[idx].Name, rt.Rules[idx].Severity = r.Name, r.Severity
+	return &rt.Rules[idx]
+}
+
+// isFinding returns true if checks with the given status are findings.
+func isFinding(status rule.Status) bool {
+	return slices.Contains([]rule.Status{rule.Warning, rule.Failed, rule.Errored}, status)
+}
+
+// targetsOrEmpty returns the targets or a single empty target if there are none.
+func targetsOrEmpty(targets []rule.Target) []rule.Target {
+	if len(targets) == 0 {
+		return []rule.Target{nil}
+	}
+	return targets
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("trend", func() {
+	var (
+		day1, day2, day3 time.Time
+		newReport        func(reportTime time.Time, checks ...report.Check) *report.Report
+	)
+
+	BeforeEach(func() {
+		day1 = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		day2 = day1.AddDate(0, 0, 1)
+		day3 = day1.AddDate(0, 0, 2)
+		newReport = func(reportTime time.Time, checks ...report.Check) *report.Report {
+			return &report.Report{
+				Time: reportTime,
+				Providers: []report.Provider{
+					{
+						ID:   "provider-foo",
+						Name: "Provider Foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Name:    "Ruleset Foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{ID: "2", Name: "Rule 2", Checks: []report.Check{{Status: rule.Passed, Message: "foo"}}},
+									{ID: "1", Name: "Rule 1", Severity: rule.SeverityHigh, Checks: checks},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+	})
+
+	Describe("#CreateTrend", func() {
+		It("should return error when no reports are provided", func() {
+			trend, err := report.CreateTrend(nil, nil)
+
+			Expect(trend).To(BeNil())
+			Expect(err).To(MatchError("zero reports provided for trend"))
+		})
+
+		It("should return error when reports do not have equal minStatus", func() {
+			report1, report2 := newReport(day1), newReport(day2)
+			report2.MinStatus = rule.Failed
+
+			trend, err := report.CreateTrend([]*report.Report{report1, report2}, nil)
+
+			Expect(trend).To(BeNil())
+			Expect(err).To(MatchError("reports must have equal minStatus in order to create a trend"))
+		})
+
+		It("should order reports by time and track when findings were first seen and resolved", func() {
+			var (
+				fooTarget = rule.NewTarget("kind", "Pod", "name", "foo")
+				barTarget = rule.NewTarget("kind", "Pod", "name", "bar")
+				report1   = newReport(day1, report.Check{Status: rule.Failed, Message: "privileged", Targets: []rule.Target{fooTarget}})
+				report2   = newReport(day2,
+					report.Check{Status: rule.Failed, Message: "privileged", Targets: []rule.Target{fooTarget, barTarget}},
+					report.Check{Status: rule.Errored, Message: "timeout"},
+				)
+				report3 = newReport(day3,
+					report.Check{Status: rule.Errored, Message: "privileged", Targets: []rule.Target{barTarget}},
+					report.Check{Status: rule.Passed, Message: "not privileged", Targets: []rule.Target{fooTarget}},
+				)
+			)
+
+			trend, err := report.CreateTrend([]*report.Report{report3, report1, report2}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(trend).To(Equal(&report.TrendReport{
+				Times: []time.Time{day1, day2, day3},
+				Rulesets: []report.RulesetTrend{
+					{
+						ProviderID:   "provider-foo",
+						ProviderName: "Provider Foo",
+						ID:           "ruleset-foo",
+						Name:         "Ruleset Foo",
+						Version:      "v1",
+						Counts: []map[rule.Status]int{
+							{rule.Passed: 1, rule.Failed: 1},
+							{rule.Passed: 1, rule.Failed: 2, rule.Errored: 1},
+							{rule.Passed: 2, rule.Errored: 1},
+						},
+						Rules: []report.RuleTrend{
+							{
+								ID:       "1",
+								Name:     "Rule 1",
+								Severity: rule.SeverityHigh,
+								Counts: []map[rule.Status]int{
+									{rule.Failed: 1},
+									{rule.Failed: 2, rule.Errored: 1},
+									{rule.Passed: 1, rule.Errored: 1},
+								},
+							},
+							{
+								ID:     "2",
+								Name:   "Rule 2",
+								Counts: []map[rule.Status]int{{rule.Passed: 1}, {rule.Passed: 1}, {rule.Passed: 1}},
+							},
+						},
+					},
+				},
+				Findings: []report.FindingTrend{
+					{
+						ProviderID: "provider-foo",
+						RulesetID:  "ruleset-foo",
+						RuleID:     "1",
+						RuleName:   "Rule 1",
+						Severity:   rule.SeverityHigh,
+						Message:    "privileged",
+						Target:     barTarget,
+						Status:     rule.Errored,
+						FirstSeen:  day2,
+						LastSeen:   day3,
+					},
+					{
+						ProviderID: "provider-foo",
+						RulesetID:  "ruleset-foo",
+						RuleID:     "1",
+						RuleName:   "Rule 1",
+						Severity:   rule.SeverityHigh,
+						Message:    "privileged",
+						Target:     fooTarget,
+						Status:     rule.Failed,
+						FirstSeen:  day1,
+						LastSeen:   day2,
+						Resolved:   ptr.To(day3),
+					},
+					{
+						ProviderID: "provider-foo",
+						RulesetID:  "ruleset-foo",
+						RuleID:     "1",
+						RuleName:   "Rule 1",
+						Severity:   rule.SeverityHigh,
+						Message:    "timeout",
+						Status:     rule.Errored,
+						FirstSeen:  day2,
+						LastSeen:   day2,
+						Resolved:   ptr.To(day3),
+					},
+				},
+			}))
+		})
+
+		It("should not count rulesets in runs that did not include them", func() {
+			report2 := newReport(day2)
+			report2.Providers[0].Rulesets[0].Version = "v2"
+
+			trend, err := report.CreateTrend([]*report.Report{newReport(day1), report2}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(trend.Rulesets).To(HaveLen(2))
+			Expect(trend.Rulesets[0].Version).To(Equal("v1"))
+			Expect(trend.Rulesets[0].Counts).To(Equal([]map[rule.Status]int{{rule.Passed: 1}, nil}))
+			Expect(trend.Rulesets[1].Version).To(Equal("v2"))
+			Expect(trend.Rulesets[1].Counts).To(Equal([]map[rule.Status]int{nil, {rule.Passed: 1}}))
+		})
+
+		It("should only resolve findings in later runs of their rule", func() {
+			var (
+				report1 = newReport(day1, report.Check{Status: rule.Failed, Message: "privileged"})
+				report2 = newReport(day2, report.Check{Status: rule.Errored, Message: "timed out"})
+				report3 = newReport(day3)
+				report4 = newReport(day3.AddDate(0, 0, 1), report.Check{Status: rule.Passed, Message: "not privileged"})
+			)
+			report2.Providers[0].Rulesets[0].TimedOutRules = []string{"1"}
+			report3.Providers[0].Rulesets[0].Rules = report3.Providers[0].Rulesets[0].Rules[:1]
+
+			trend, err := report.CreateTrend([]*report.Report{report1, report2, report3}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(trend.Findings).To(HaveLen(2))
+			Expect(trend.Findings[0].Message).To(Equal("privileged"))
+			Expect(trend.Findings[0].Resolved).To(BeNil())
+
+			trend, err = report.CreateTrend([]*report.Report{report1, report2, report3, report4}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(trend.Findings[0].Message).To(Equal("privileged"))
+			Expect(trend.Findings[0].Resolved).To(Equal(ptr.To(report4.Time)))
+		})
+
+		It("should return error when the identity attributes of the reports differ", func() {
+			report1, report2 := newReport(day1), newReport(day2)
+			report1.Providers[0].Metadata = map[string]string{"id": "foo", "time": "1"}
+			report2.Providers[0].Metadata = map[string]string{"id": "foo", "time": "2"}
+
+			_, err := report.CreateTrend([]*report.Report{report1, report2}, map[string]string{"provider-foo": "id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			trend, err := report.CreateTrend([]*report.Report{report1, report2}, map[string]string{"provider-foo": "time"})
+			Expect(trend).To(BeNil())
+			Expect(err).To(MatchError("reports must have equal time metadata of provider provider-foo in order to create a trend"))
+		})
+	})
+
+	Describe("HTMLRenderer", func() {
+		It("should render charts and findings of a trend report", func() {
+			trend, err := report.CreateTrend([]*report.Report{
+				newReport(day1, report.Check{Status: rule.Failed, Message: "privileged"}),
+				newReport(day2, report.Check{Status: rule.Passed, Message: "not privileged"}),
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			renderer, err := report.NewHTMLRenderer()
+			Expect(err).NotTo(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			Expect(renderer.Render(buf, trend)).To(Succeed())
+			Expect(buf.String()).To(And(
+				ContainSubstring("Compliance Trend (01-01-2000 - 01-02-2000)"),
+				ContainSubstring(`<rect x="0" y="0" width="16" height="60" fill="#ef4444"><title>2000-01-01: 1x Failed</title></rect>`),
+				ContainSubstring(`<rect x="24" y="0" width="16" height="120" fill="#22c55e"><title>2000-01-02: 2x Passed</title></rect>`),
+				ContainSubstring("Resolved Findings (1)"),
+				Not(ContainSubstring("Open Findings")),
+			))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ExpirationLayout is the layout of the expiration dates of exceptions.
+const ExpirationLayout = time.DateOnly
+
+// Exception contains the optional metadata of a configured acceptance or skip.
+type Exception struct {
+	// ExpiresAt is the date on which the exception lapses, e.g. "2026-12-31".
+	// Exceptions without an expiration date do not lapse.
+	ExpiresAt string `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	// Owner is the person or team responsible for the exception.
+	Owner string `json:"owner,omitempty" yaml:"owner,omitempty"`
+	// Reference is a link to or the id of the ticket that tracks the exception.
+	Reference string `json:"reference,omitempty" yaml:"reference,omitempty"`
+}
+
+// IsEmpty returns true if no metadata is set.
+func (e Exception) IsEmpty() bool {
+	return e == Exception{}
+}
+
+// Expiration returns the time at which the exception lapses.
+// The returned time is zero if the exception does not have an expiration date.
+// RFC3339 timestamps at midnight are accepted as well, since YAML decodes
+// unquoted dates as timestamps when the exception is part of untyped rule args.
+func (e Exception) Expiration() (time.Time, error) {
+	if len(e.ExpiresAt) == 0 {
+		return time.Time{}, nil
+	}
+	if expiration, err := time.Parse(ExpirationLayout, e.ExpiresAt); err == nil {
+		return expiration, nil
+	}
+	if timestamp, err := time.Parse(time.RFC3339, e.ExpiresAt); err == nil {
+		date := time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, timestamp.Location())
+		if timestamp.Equal(date) {
+			return time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid expiration date %s, expected format YYYY-MM-DD", e.ExpiresAt)
+}
+
+// Lapsed returns true if the exception has expired at the given time.
+// Exceptions with invalid expiration dates are considered lapsed.
+func (e Exception) Lapsed(now time.Time) bool {
+	expiration, err := e.Expiration()
+	return err != nil || (!expiration.IsZero() && !now.Before(expiration))
+}
+
+// CheckResult returns an [Accepted] check result with the given message if the exception has
+// not lapsed at the given time. Otherwise it returns a [Failed] check result that states that the
+// exception has lapsed. The exception is attached to the check result if it has metadata.
+func (e Exception) CheckResult(message string, target Target, now time.Time) CheckResult {
+	checkResult := AcceptedCheckResult(message, target)
+	if e.Lapsed(now) {
+		checkResult = FailedCheckResult(e.lapsedMessage(message), target)
+	}
+	if !e.IsEmpty() {
+		exception := e
+		checkResult.Exception = &exception
+	}
+	return checkResult
+}
+
+// AcceptedCheckResult returns the result of [Exception.CheckResult] at the current time.
+func (e Exception) AcceptedCheckResult(message string, target Target) CheckResult {
+	return e.CheckResult(message, target, time.Now())
+}
+
+func (e Exception) lapsedMessage(message string) string {
+	var details []string
+	if len(e.Owner) > 0 {
+		details = append(details, "owner: "+e.Owner)
+	}
+	if len(e.Reference) > 0 {
+		details = append(details, "reference: "+e.Reference)
+	}
+
+	lapsed := fmt.Sprintf("Exception lapsed on %s", e.ExpiresAt)
+	if len(details) > 0 {
+		lapsed = fmt.Sprintf("%s (%s)", lapsed, strings.Join(details, ", "))
+	}
+	return fmt.Sprintf("%s: %s", lapsed, message)
+}
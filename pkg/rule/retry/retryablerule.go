@@ -23,6 +23,7 @@ type Logger interface {
 var (
 	_ rule.Rule     = &RetryableRule{}
 	_ rule.Severity = &RetryableRule{}
+	_ rule.Labels   = &RetryableRule{}
 )
 
 // RetryableRule wraps [rule.Rule] and allows a rule to be retried when the retry condition is met.
@@ -71,6 +72,17 @@ func (rr *RetryableRule) Severity() rule.SeverityLevel {
 	return severity
 }
 
+// Labels returns the labels of the Rule.
+func (rr *RetryableRule) Labels() map[string]string {
+	var labels map[string]string
+
+	if l, ok := rr.BaseRule.(rule.Labels); ok {
+		labels = l.Labels()
+	}
+
+	return labels
+}
+
 // Run executes the base rule and retries when the retry condition is met and max retries are not reached yet.
 func (rr *RetryableRule) Run(ctx context.Context) (rule.RuleResult, error) {
 	var (
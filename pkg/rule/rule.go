@@ -36,11 +36,46 @@ const (
 	SeverityHigh SeverityLevel = "High"
 )
 
+// SeverityLevels returns all supported severity levels in ascending order.
+func SeverityLevels() []SeverityLevel {
+	return []SeverityLevel{SeverityLow, SeverityMedium, SeverityHigh}
+}
+
+// Less is used to define the priority of the severity levels.
+// The ascending order is as follows
+// Low, Medium, High
+// An unknown or empty severity level is less than all known ones.
+func (a SeverityLevel) Less(b SeverityLevel) bool {
+	levels := SeverityLevels()
+	return slices.Index(levels, a) < slices.Index(levels, b)
+}
+
 // Severity defines the importance of a rule.
 type Severity interface {
 	Severity() SeverityLevel
 }
 
+// LabelSeverity is the label key that holds the severity level of a rule.
+const LabelSeverity = "severity"
+
+// Labels defines additional labels of a rule.
+type Labels interface {
+	Labels() map[string]string
+}
+
+// LabelsOf returns the labels of a rule. The severity level
+// of the rule is added as the [LabelSeverity] label if set.
+func LabelsOf(r Rule) map[string]string {
+	ruleLabels := map[string]string{}
+	if l, ok := r.(Labels); ok {
+		maps.Copy(ruleLabels, l.Labels())
+	}
+	if s, ok := r.(Severity); ok && len(s.Severity()) > 0 {
+		ruleLabels[LabelSeverity] = string(s.Severity())
+	}
+	return ruleLabels
+}
+
 // Target is used to describe the things that were checked during ruleset runs.
 type Target map[string]string
 
@@ -79,6 +114,8 @@ type CheckResult struct {
 	Status  Status
 	Message string
 	Target  Target
+	// Exception is the metadata of the acceptance or skip that produced the result, if any.
+	Exception *Exception `json:",omitempty"`
 }
 
 // Status of a CheckResult
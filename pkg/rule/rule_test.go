@@ -5,6 +5,9 @@
 package rule_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -20,6 +23,90 @@ var _ = Describe("rule", func() {
 		Entry("Accepted should not be less than Passed", rule.Accepted, rule.Passed, false),
 	)
 
+	DescribeTable("#SeverityLevel.Less",
+		func(s1, s2 rule.SeverityLevel, expectedResult bool) {
+			Expect(s1.Less(s2)).To(Equal(expectedResult))
+		},
+		Entry("Low should be less than High", rule.SeverityLow, rule.SeverityHigh, true),
+		Entry("High should not be less than Medium", rule.SeverityHigh, rule.SeverityMedium, false),
+		Entry("Medium should not be less than Medium", rule.SeverityMedium, rule.SeverityMedium, false),
+		Entry("empty severity should be less than Low", rule.SeverityLevel(""), rule.SeverityLow, true),
+	)
+
+	Describe("#LabelsOf", func() {
+		It("should return the labels and severity of a rule", func() {
+			r := rule.NewSkipRule("1", "foo", "", rule.Passed, rule.SkipRuleWithSeverity(rule.SeverityHigh), rule.SkipRuleWithLabels(map[string]string{"foo": "bar"}))
+			Expect(rule.LabelsOf(r)).To(Equal(map[string]string{"foo": "bar", rule.LabelSeverity: "High"}))
+		})
+
+		It("should return empty labels for a rule without labels and severity", func() {
+			r := rule.NewSkipRule("1", "foo", "", rule.Passed)
+			Expect(rule.LabelsOf(r)).To(BeEmpty())
+		})
+	})
+
+	Describe("#SkipRule", func() {
+		It("should return its predefined status and justification", func() {
+			r := rule.NewSkipRule("1", "foo", "not relevant", rule.NotImplemented)
+			Expect(r.Status()).To(Equal(rule.NotImplemented))
+			Expect(r.Justification()).To(Equal("not relevant"))
+		})
+
+		It("should report its exception until it lapses", func() {
+			exception := rule.Exception{ExpiresAt: "9999-12-31", Owner: "team-foo"}
+			result, err := rule.NewSkipRule("1", "foo", "not relevant", rule.Accepted, rule.SkipRuleWithException(exception)).Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Accepted, Message: "not relevant", Exception: &exception}}))
+
+			exception.ExpiresAt = "2000-01-01"
+			result, err = rule.NewSkipRule("1", "foo", "not relevant", rule.Accepted, rule.SkipRuleWithException(exception)).Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Failed, Message: "Exception lapsed on 2000-01-01 (owner: team-foo): not relevant", Exception: &exception}}))
+		})
+	})
+
+	Describe("#Exception", func() {
+		var (
+			now    = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+			target = rule.NewTarget("name", "foo")
+		)
+
+		It("should return an accepted check result without exception when no metadata is set", func() {
+			Expect(rule.Exception{}.CheckResult("accepted", target, now)).To(Equal(rule.AcceptedCheckResult("accepted", target)))
+		})
+
+		It("should return an accepted check result with the exception before it lapses", func() {
+			exception := rule.Exception{ExpiresAt: "2000-01-02", Reference: "https://example.com/issues/1"}
+			Expect(exception.CheckResult("accepted", target, now)).To(Equal(rule.CheckResult{Status: rule.Accepted, Message: "accepted", Target: target, Exception: &exception}))
+		})
+
+		It("should return a failed check result once the exception lapses", func() {
+			exception := rule.Exception{ExpiresAt: "2000-01-01", Owner: "team-foo", Reference: "https://example.com/issues/1"}
+			Expect(exception.CheckResult("accepted", target, now)).To(Equal(rule.CheckResult{
+				Status:    rule.Failed,
+				Message:   "Exception lapsed on 2000-01-01 (owner: team-foo, reference: https://example.com/issues/1): accepted",
+				Target:    target,
+				Exception: &exception,
+			}))
+		})
+
+		It("should accept expiration dates decoded as timestamps at midnight", func() {
+			expiration, err := rule.Exception{ExpiresAt: "2000-01-02T00:00:00Z"}.Expiration()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiration).To(Equal(time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC)))
+
+			_, err = rule.Exception{ExpiresAt: "2000-01-02T12:00:00Z"}.Expiration()
+			Expect(err).To(MatchError("invalid expiration date 2000-01-02T12:00:00Z, expected format YYYY-MM-DD"))
+		})
+
+		It("should consider exceptions with invalid expiration dates as lapsed", func() {
+			exception := rule.Exception{ExpiresAt: "tomorrow"}
+			_, err := exception.Expiration()
+			Expect(err).To(MatchError("invalid expiration date tomorrow, expected format YYYY-MM-DD"))
+			Expect(exception.Lapsed(now)).To(BeTrue())
+		})
+	})
+
 	Describe("#Target", func() {
 		It("should correctly initialize", func() {
 			t := rule.NewTarget("foo", "bar", "one", "two")
@@ -6,11 +6,14 @@ package rule
 
 import (
 	"context"
+	"maps"
+	"time"
 )
 
 var (
 	_ Rule     = &SkipRule{}
 	_ Severity = &SkipRule{}
+	_ Labels   = &SkipRule{}
 )
 
 // SkipRule is a Rule that always reports a predefined status.
@@ -20,6 +23,8 @@ type SkipRule struct {
 	severity      SeverityLevel
 	justification string
 	status        Status
+	labels        map[string]string
+	exception     *Exception
 }
 
 // SkipRuleOption allows to additionally configure a SkipRule.
@@ -32,6 +37,23 @@ func SkipRuleWithSeverity(severity SeverityLevel) SkipRuleOption {
 	}
 }
 
+// SkipRuleWithLabels allows configuring the labels of a SkipRule.
+func SkipRuleWithLabels(labels map[string]string) SkipRuleOption {
+	return func(skipRule *SkipRule) {
+		skipRule.labels = maps.Clone(labels)
+	}
+}
+
+// SkipRuleWithException allows configuring the metadata of the skip.
+// Once the exception lapses the SkipRule reports a [Failed] check instead of its predefined status.
+func SkipRuleWithException(exception Exception) SkipRuleOption {
+	return func(skipRule *SkipRule) {
+		if !exception.IsEmpty() {
+			skipRule.exception = &exception
+		}
+	}
+}
+
 // NewSkipRule returns a new skipped Rule.
 func NewSkipRule(id, name, justification string, status Status, options ...SkipRuleOption) *SkipRule {
 	skipRule := &SkipRule{
@@ -63,13 +85,32 @@ func (s *SkipRule) Severity() SeverityLevel {
 	return s.severity
 }
 
+// Labels returns the labels of the Rule.
+func (s *SkipRule) Labels() map[string]string {
+	return s.labels
+}
+
+// Status returns the predefined status reported by the Rule.
+func (s *SkipRule) Status() Status {
+	return s.status
+}
+
+// Justification returns the predefined justification reported by the Rule.
+func (s *SkipRule) Justification() string {
+	return s.justification
+}
+
 // Run immediately returns a RuleResult containing
 // a single CheckResult with a predefined status and justification.
 func (s *SkipRule) Run(context.Context) (RuleResult, error) {
-	return Result(s, []CheckResult{
-		{
-			Status:  s.status,
-			Message: s.justification,
-		},
-	}...), nil
+	checkResult := CheckResult{
+		Status:    s.status,
+		Message:   s.justification,
+		Exception: s.exception,
+	}
+	if s.exception != nil && s.exception.Lapsed(time.Now()) {
+		checkResult.Status = Failed
+		checkResult.Message = s.exception.lapsedMessage(s.justification)
+	}
+	return Result(s, checkResult), nil
 }
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// RuleFilter selects the rules of a ruleset that should be run.
+// An empty RuleFilter selects all rules.
+type RuleFilter struct {
+	// Rules are rule ids or glob patterns of rule ids. If set only matching rules are selected.
+	Rules []string `json:"rules,omitempty"`
+	// ExcludeRules are rule ids or glob patterns of rule ids. Matching rules are not selected.
+	ExcludeRules []string `json:"excludeRules,omitempty"`
+	// MinSeverity is the minimal severity of the selected rules.
+	MinSeverity rule.SeverityLevel `json:"minSeverity,omitempty"`
+}
+
+// IsEmpty returns true if the filter selects all rules.
+func (f RuleFilter) IsEmpty() bool {
+	return len(f.Rules) == 0 && len(f.ExcludeRules) == 0 && len(f.MinSeverity) == 0
+}
+
+// Matcher validates the filter and returns a [RuleMatcher] for it.
+func (f RuleFilter) Matcher() (RuleMatcher, error) {
+	for _, pattern := range slices.Concat(f.Rules, f.ExcludeRules) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid rule id pattern %s: %w", pattern, err)
+		}
+	}
+
+	if len(f.MinSeverity) > 0 && !slices.Contains(rule.SeverityLevels(), f.MinSeverity) {
+		return nil, fmt.Errorf("not defined severity: %s", f.MinSeverity)
+	}
+
+	return func(r rule.Rule) bool {
+		if len(f.Rules) > 0 && !matchesAny(f.Rules, r.ID()) {
+			return false
+		}
+
+		if matchesAny(f.ExcludeRules, r.ID()) {
+			return false
+		}
+
+		if len(f.MinSeverity) > 0 {
+			var severity rule.SeverityLevel
+			if s, ok := r.(rule.Severity); ok {
+				severity = s.Severity()
+			}
+			return !severity.Less(f.MinSeverity)
+		}
+
+		return true
+	}, nil
+}
+
+func matchesAny(patterns []string, id string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		// patterns are validated beforehand
+		matched, _ := path.Match(pattern, id)
+		return matched
+	})
+}
+
+// RuleMatcher reports whether a rule should be run.
+type RuleMatcher func(r rule.Rule) bool
+
+// String returns a human readable representation of the filter.
+func (f RuleFilter) String() string {
+	var parts []string
+	if len(f.Rules) > 0 {
+		parts = append(parts, fmt.Sprintf("rules=%v", f.Rules))
+	}
+	if len(f.ExcludeRules) > 0 {
+		parts = append(parts, fmt.Sprintf("excludeRules=%v", f.ExcludeRules))
+	}
+	if len(f.MinSeverity) > 0 {
+		parts = append(parts, fmt.Sprintf("minSeverity=%s", f.MinSeverity))
+	}
+	return strings.Join(parts, ", ")
+}
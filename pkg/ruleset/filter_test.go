@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var _ = Describe("filter", func() {
+	var rules []rule.Rule
+
+	BeforeEach(func() {
+		rules = []rule.Rule{
+			rule.NewSkipRule("242400", "foo", "", rule.Passed, rule.SkipRuleWithSeverity(rule.SeverityMedium)),
+			rule.NewSkipRule("242414", "foo", "", rule.Passed, rule.SkipRuleWithSeverity(rule.SeverityHigh)),
+			rule.NewSkipRule("242451", "foo", "", rule.Passed, rule.SkipRuleWithSeverity(rule.SeverityLow)),
+			rule.NewSkipRule("1000", "foo", "", rule.Passed),
+		}
+	})
+
+	DescribeTable("#RuleFilter.Matcher",
+		func(filter ruleset.RuleFilter, expectedIDs []string) {
+			matcher, err := filter.Matcher()
+			Expect(err).NotTo(HaveOccurred())
+
+			var ids []string
+			for _, r := range rules {
+				if matcher(r) {
+					ids = append(ids, r.ID())
+				}
+			}
+			Expect(ids).To(Equal(expectedIDs))
+		},
+		Entry("should match all rules when empty", ruleset.RuleFilter{}, []string{"242400", "242414", "242451", "1000"}),
+		Entry("should match rule ids and globs", ruleset.RuleFilter{Rules: []string{"1000", "24241*"}}, []string{"242414", "1000"}),
+		Entry("should not match excluded rules", ruleset.RuleFilter{ExcludeRules: []string{"2424?1", "1000"}}, []string{"242400", "242414"}),
+		Entry("should match rules with min severity", ruleset.RuleFilter{MinSeverity: rule.SeverityMedium}, []string{"242400", "242414"}),
+		Entry("should combine all filters", ruleset.RuleFilter{Rules: []string{"2424*"}, ExcludeRules: []string{"242400"}, MinSeverity: rule.SeverityLow}, []string{"242414", "242451"}),
+	)
+
+	DescribeTable("#RuleFilter.Matcher errors",
+		func(filter ruleset.RuleFilter, expectedErr string) {
+			_, err := filter.Matcher()
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("should error on invalid glob", ruleset.RuleFilter{Rules: []string{"[24"}}, "invalid rule id pattern [24"),
+		Entry("should error on unknown severity", ruleset.RuleFilter{MinSeverity: "Critical"}, "not defined severity: Critical"),
+	)
+
+	Describe("#RuleFilter.String", func() {
+		It("should describe the set filters", func() {
+			filter := ruleset.RuleFilter{Rules: []string{"1", "2*"}, MinSeverity: rule.SeverityHigh}
+			Expect(filter.IsEmpty()).To(BeFalse())
+			Expect(filter.String()).To(Equal("rules=[1 2*], minSeverity=High"))
+		})
+	})
+})
@@ -16,6 +16,11 @@ type RulesetResult struct {
 	RulesetName    string
 	RulesetVersion string
 	RuleResults    []rule.RuleResult
+	// TimedOutRules are the ids of the rules that exceeded their deadline.
+	TimedOutRules []string
+	// ResumedRules are the ids of the rules whose results
+	// were taken from a previous run instead of being run again.
+	ResumedRules []string
 }
 
 // Ruleset is a set of Rules.
@@ -23,6 +28,32 @@ type Ruleset interface {
 	ID() string
 	Name() string
 	Version() string
-	Run(ctx context.Context) (RulesetResult, error)
+	// Run runs all rules of the ruleset that are selected by the hooks. It can return
+	// partial results together with a non-nil error.
+	Run(ctx context.Context, hooks RunHooks) (RulesetResult, error)
 	RunRule(ctx context.Context, id string) (rule.RuleResult, error)
 }
+
+// RunHooks customize the run of a [Ruleset]. All hooks are optional.
+type RunHooks struct {
+	// Matcher selects the rules that are run. All rules are run if it is not set.
+	Matcher RuleMatcher
+	// Resumed returns the results of rules from a previous run. These rules are not run again.
+	Resumed ResumedRuleResults
+	// Modifier is applied to all rule results, including resumed ones, before they are handled or returned.
+	Modifier RuleResultModifier
+	// Handler is called with each rule result, including resumed ones, as soon as it is available.
+	Handler RuleResultHandler
+}
+
+// RuleResultHandler handles the result of a single rule as soon as the rule run finishes.
+// The passed result contains the ruleset identification and exactly one rule result.
+type RuleResultHandler func(result RulesetResult)
+
+// ResumedRuleResults returns the result of a rule from a previous run.
+// The returned bool reports whether such a result exists.
+type ResumedRuleResults func(rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, bool)
+
+// RuleResultModifier modifies the result of a rule after the rule run finishes,
+// e.g. to accept known findings. It must return the passed result if nothing is changed.
+type RuleResultModifier func(rulesetID, rulesetVersion string, result rule.RuleResult) rule.RuleResult
@@ -8,6 +8,7 @@ import (
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 )
 
@@ -67,11 +68,13 @@ func (s *NamespacedObjectSelector) Validate() field.ErrorList {
 // AcceptedClusterObject contains generalized properties for accepting object.
 type AcceptedClusterObject struct {
 	ClusterObjectSelector
+	rule.Exception
 	Justification string `json:"justification" yaml:"justification"`
 }
 
 // AcceptedNamespacedObject contains generalized properties for accepting namespaced object.
 type AcceptedNamespacedObject struct {
 	NamespacedObjectSelector
+	rule.Exception
 	Justification string `json:"justification" yaml:"justification"`
 }
@@ -5,10 +5,13 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"sync"
 
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/ruleset"
@@ -21,11 +24,21 @@ type Logger interface {
 }
 
 // RunAll is a sample implementation for a [provider.Provider].
-func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]ruleset.Ruleset, log Logger) (provider.ProviderResult, error) {
+// Up to numWorkers rulesets are run concurrently.
+// The returned result contains the results of all rulesets that have run,
+// including partial results of rulesets that returned an error.
+// Rulesets that were not started before ctx is done are reported in the returned error.
+// The given hooks are passed to the run of each ruleset.
+func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]ruleset.Ruleset, hooks ruleset.RunHooks, numWorkers int, log Logger) (provider.ProviderResult, error) {
 	if len(rulesets) == 0 {
 		return provider.ProviderResult{}, fmt.Errorf("no rulests are registered with the provider")
 	}
 
+	workers := 1
+	if numWorkers > 0 {
+		workers = numWorkers
+	}
+
 	result := provider.ProviderResult{
 		ProviderName:   p.Name(),
 		ProviderID:     p.ID(),
@@ -33,28 +46,87 @@ func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]rulese
 		RulesetResults: make([]ruleset.RulesetResult, 0, len(rulesets)),
 	}
 
-	var errAgg error
-	log.Info("starting provider run", "number_of_rulesets", len(rulesets))
-	finishMsg := "finished ruleset run"
-	for _, rs := range rulesets {
-		select {
-		case <-ctx.Done():
-			return provider.ProviderResult{}, ctx.Err()
-		default:
-			log.Info("starting ruleset run", "ruleset", rs.ID(), "version", rs.Version())
-			if res, err := rs.Run(ctx); err != nil {
-				errAgg = errors.Join(errAgg, fmt.Errorf("ruleset with id %s and version %s errored: %w", rs.ID(), rs.Version(), err))
-				log.Error(finishMsg, "ruleset", rs.ID(), "version", rs.Version(), "error", err)
-			} else {
-				result.RulesetResults = append(result.RulesetResults, res)
-				log.Info(finishMsg, "ruleset", rs.ID(), "version", rs.Version())
+	type run struct {
+		ruleset ruleset.Ruleset
+		result  ruleset.RulesetResult
+		err     error
+	}
+
+	rulesetsCh := make(chan ruleset.Ruleset)
+	resultCh := make(chan run)
+
+	wg := sync.WaitGroup{}
+	log.Info("starting provider run", "number_of_rulesets", len(rulesets), "number_of_workers", workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			for rs := range rulesetsCh {
+				if ctx.Err() != nil {
+					// the ruleset is reported as not started
+					continue
+				}
+				log.Info("starting ruleset run", "ruleset", rs.ID(), "version", rs.Version())
+				res, err := rs.Run(ctx, hooks)
+				resultCh <- run{ruleset: rs, result: res, err: err}
+			}
+			wg.Done()
+		}()
+	}
+
+	go func() {
+		defer close(rulesetsCh)
+		for _, rs := range rulesets {
+			select {
+			case <-ctx.Done():
+				return
+			case rulesetsCh <- rs:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	var (
+		errAgg      error
+		finishMsg   = "finished ruleset run"
+		rulesetsRun = make(map[[2]string]struct{}, len(rulesets))
+	)
+	for run := range resultCh {
+		rulesetsRun[[2]string{run.ruleset.ID(), run.ruleset.Version()}] = struct{}{}
+		if run.err != nil {
+			errAgg = errors.Join(errAgg, fmt.Errorf("ruleset with id %s and version %s errored: %w", run.ruleset.ID(), run.ruleset.Version(), run.err))
+			log.Error(finishMsg, "ruleset", run.ruleset.ID(), "version", run.ruleset.Version(), "error", run.err)
+		} else {
+			log.Info(finishMsg, "ruleset", run.ruleset.ID(), "version", run.ruleset.Version())
+		}
+		if len(run.result.RuleResults) > 0 {
+			result.RulesetResults = append(result.RulesetResults, run.result)
+		}
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		for _, key := range slices.Sorted(maps.Keys(rulesets)) {
+			rs := rulesets[key]
+			if _, ok := rulesetsRun[[2]string{rs.ID(), rs.Version()}]; ok {
+				continue
 			}
+			errAgg = errors.Join(errAgg, fmt.Errorf("ruleset with id %s and version %s was not started: %w", rs.ID(), rs.Version(), ctxErr))
+			log.Error("ruleset run was not started", "ruleset", rs.ID(), "version", rs.Version(), "error", ctxErr)
 		}
 	}
 	log.Info("finished provider run")
 
-	if errAgg != nil {
-		return provider.ProviderResult{}, errAgg
+	// sort rulesets to ensure static order regardless of the order in which they finished
+	slices.SortFunc(result.RulesetResults, func(a, b ruleset.RulesetResult) int {
+		return cmp.Or(cmp.Compare(a.RulesetID, b.RulesetID), cmp.Compare(a.RulesetVersion, b.RulesetVersion))
+	})
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return result, errors.Join(ctxErr, errAgg)
 	}
-	return result, nil
+
+	return result, errAgg
 }
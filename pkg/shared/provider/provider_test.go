@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+var _ provider.Provider = &fakeProvider{}
+
+type fakeProvider struct{}
+
+func (p *fakeProvider) ID() string {
+	return "fake"
+}
+
+func (p *fakeProvider) Name() string {
+	return "Fake"
+}
+
+func (p *fakeProvider) Metadata() map[string]string {
+	return map[string]string{"foo": "bar"}
+}
+
+func (p *fakeProvider) RunAll(context.Context, ruleset.RunHooks) (provider.ProviderResult, error) {
+	return provider.ProviderResult{}, nil
+}
+
+func (p *fakeProvider) RunRuleset(context.Context, string, string, ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+
+func (p *fakeProvider) RunRule(context.Context, string, string, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+
+var _ ruleset.Ruleset = &fakeRuleset{}
+
+type fakeRuleset struct {
+	id  string
+	run func(ctx context.Context) (ruleset.RulesetResult, error)
+}
+
+func (r *fakeRuleset) ID() string {
+	return r.id
+}
+
+func (r *fakeRuleset) Name() string {
+	return "Fake " + r.id
+}
+
+func (r *fakeRuleset) Version() string {
+	return "v1"
+}
+
+func (r *fakeRuleset) Run(ctx context.Context, _ ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return r.run(ctx)
+}
+
+func (r *fakeRuleset) RunRule(context.Context, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+
+var _ = Describe("provider", func() {
+	Describe("#RunAll", func() {
+		var (
+			ctx    context.Context
+			logger *slog.Logger
+			p      *fakeProvider
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+			p = &fakeProvider{}
+		})
+
+		rulesetResult := func(id string) ruleset.RulesetResult {
+			return ruleset.RulesetResult{
+				RulesetID:      id,
+				RulesetName:    "Fake " + id,
+				RulesetVersion: "v1",
+				RuleResults: []rule.RuleResult{
+					{RuleID: "1", CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())}},
+				},
+			}
+		}
+
+		It("should return an error when no rulesets are registered", func() {
+			_, err := sharedprovider.RunAll(ctx, p, map[string]ruleset.Ruleset{}, ruleset.RunHooks{}, 1, logger)
+
+			Expect(err).To(MatchError("no rulests are registered with the provider"))
+		})
+
+		It("should return partial results together with the ruleset errors", func() {
+			rulesets := map[string]ruleset.Ruleset{
+				"b": &fakeRuleset{id: "b", run: func(context.Context) (ruleset.RulesetResult, error) {
+					return rulesetResult("b"), errors.New("foo")
+				}},
+				"a": &fakeRuleset{id: "a", run: func(context.Context) (ruleset.RulesetResult, error) {
+					return rulesetResult("a"), nil
+				}},
+				"c": &fakeRuleset{id: "c", run: func(context.Context) (ruleset.RulesetResult, error) {
+					return ruleset.RulesetResult{}, errors.New("bar")
+				}},
+			}
+
+			res, err := sharedprovider.RunAll(ctx, p, rulesets, ruleset.RunHooks{}, 1, logger)
+
+			Expect(err).To(MatchError(ContainSubstring("ruleset with id b and version v1 errored: foo")))
+			Expect(err).To(MatchError(ContainSubstring("ruleset with id c and version v1 errored: bar")))
+			Expect(res.ProviderID).To(Equal("fake"))
+			Expect(res.Metadata).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(res.RulesetResults).To(Equal([]ruleset.RulesetResult{rulesetResult("a"), rulesetResult("b")}))
+		})
+
+		It("should run up to numWorkers rulesets concurrently", func() {
+			var running, maxRunning atomic.Int32
+			run := func(id string) func(context.Context) (ruleset.RulesetResult, error) {
+				return func(context.Context) (ruleset.RulesetResult, error) {
+					current := running.Add(1)
+					for {
+						m := maxRunning.Load()
+						if current <= m || maxRunning.CompareAndSwap(m, current) {
+							break
+						}
+					}
+					time.Sleep(50 * time.Millisecond)
+					running.Add(-1)
+					return rulesetResult(id), nil
+				}
+			}
+			rulesets := map[string]ruleset.Ruleset{}
+			for _, id := range []string{"a", "b", "c", "d"} {
+				rulesets[id] = &fakeRuleset{id: id, run: run(id)}
+			}
+
+			res, err := sharedprovider.RunAll(ctx, p, rulesets, ruleset.RunHooks{}, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RulesetResults).To(HaveLen(4))
+			Expect(maxRunning.Load()).To(Equal(int32(2)))
+		})
+
+		It("should report rulesets that were not started before the deadline", func() {
+			ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+
+			rulesets := map[string]ruleset.Ruleset{}
+			for _, id := range []string{"a", "b", "c"} {
+				rulesets[id] = &fakeRuleset{id: id, run: func(ctx context.Context) (ruleset.RulesetResult, error) {
+					<-ctx.Done()
+					return rulesetResult(id), ctx.Err()
+				}}
+			}
+
+			res, err := sharedprovider.RunAll(ctx, p, rulesets, ruleset.RunHooks{}, 1, logger)
+
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(res.RulesetResults).To(HaveLen(1))
+			for _, id := range []string{"a", "b", "c"} {
+				if slices.ContainsFunc(res.RulesetResults, func(result ruleset.RulesetResult) bool { return result.RulesetID == id }) {
+					Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("ruleset with id %s and version v1 errored: %s", id, context.DeadlineExceeded))))
+					continue
+				}
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("ruleset with id %s and version v1 was not started: %s", id, context.DeadlineExceeded))))
+			}
+		})
+	})
+})
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"log/slog"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// CreateOption is a function that acts on a [Ruleset]
+// and is used to construct such objects.
+type CreateOption func(*Ruleset)
+
+// WithVersion sets the version of a [Ruleset].
+func WithVersion(version string) CreateOption {
+	return func(r *Ruleset) {
+		r.version = version
+	}
+}
+
+// WithConfig sets the Config of a [Ruleset].
+func WithConfig(config *rest.Config) CreateOption {
+	return func(r *Ruleset) {
+		r.Config = config
+	}
+}
+
+// WithNumberOfWorkers sets the max number of Workers of a [Ruleset].
+func WithNumberOfWorkers(numWorkers int) CreateOption {
+	return func(r *Ruleset) {
+		if numWorkers <= 0 {
+			panic("number of workers should be a possitive number")
+		}
+		r.numWorkers = numWorkers
+	}
+}
+
+// WithTimeout sets the deadline of the whole run of a [Ruleset].
+func WithTimeout(timeout time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.timeout = timeout
+	}
+}
+
+// WithRuleTimeouts sets the deadlines of single rule runs of a [Ruleset] by rule id.
+func WithRuleTimeouts(ruleTimeouts map[string]time.Duration) CreateOption {
+	return func(r *Ruleset) {
+		r.ruleTimeouts = ruleTimeouts
+	}
+}
+
+// WithLogger the logger of a [Ruleset].
+func WithLogger(logger *slog.Logger) CreateOption {
+	return func(r *Ruleset) {
+		r.logger = logger
+	}
+}
+
+// WithArgs sets the args of a [Ruleset].
+func WithArgs(args Args) CreateOption {
+	return func(r *Ruleset) {
+		r.args = args
+	}
+}
+
+// WithInspectionOnly creates the rules of a [Ruleset] without the clients of the cluster.
+// Such rules can only be inspected, but must not be run.
+func WithInspectionOnly() CreateOption {
+	return func(r *Ruleset) {
+		r.inspectionOnly = true
+	}
+}
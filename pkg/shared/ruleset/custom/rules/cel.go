@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/kubernetes/option"
+)
+
+var (
+	_ rule.Rule     = &CELRule{}
+	_ rule.Severity = &CELRule{}
+)
+
+// ObjectVariable is the name of the variable that holds the evaluated object in CEL expressions.
+const ObjectVariable = "object"
+
+// CELRuleDefinition defines a rule that evaluates a CEL expression for each selected object.
+type CELRuleDefinition struct {
+	// ID is the unique identifier of the rule within the ruleset.
+	ID string `json:"id" yaml:"id"`
+	// Name is the user friendly name of the rule.
+	Name string `json:"name" yaml:"name"`
+	// Severity is the severity of the rule, one of 'Low', 'Medium' or 'High'.
+	Severity rule.SeverityLevel `json:"severity" yaml:"severity"`
+	// APIVersion is the group and version of the evaluated objects, e.g. "apps/v1".
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	// Kind is the kind of the evaluated objects, e.g. "Deployment".
+	Kind string `json:"kind" yaml:"kind"`
+	// NamespacedObjectSelector selects the evaluated objects by their labels and the labels of their namespace.
+	// Empty label sets select all objects. Cluster scoped objects are not selected when namespace labels are set.
+	option.NamespacedObjectSelector
+	// Expression is a CEL expression that must evaluate to true for compliant objects.
+	// The object is available as the "object" variable.
+	Expression string `json:"expression" yaml:"expression"`
+	// Message is the message of the checks of objects that are not compliant.
+	Message string `json:"message" yaml:"message"`
+}
+
+// Validate validates that the rule definition is correctly defined.
+func (d CELRuleDefinition) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(d.ID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "must not be empty"))
+	}
+
+	if len(d.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must not be empty"))
+	}
+
+	if !slices.Contains(rule.SeverityLevels(), d.Severity) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), d.Severity, rule.SeverityLevels()))
+	}
+
+	if _, err := schema.ParseGroupVersion(d.APIVersion); err != nil || len(d.APIVersion) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("apiVersion"), d.APIVersion, "must be a valid group version"))
+	}
+
+	if len(d.Kind) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "must not be empty"))
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(d.MatchLabels, fldPath.Child("matchLabels"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(d.NamespaceMatchLabels, fldPath.Child("namespaceMatchLabels"))...)
+
+	if len(d.Expression) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expression"), "must not be empty"))
+	} else if _, err := compile(d.Expression); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expression"), d.Expression, err.Error()))
+	}
+
+	if len(d.Message) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("message"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
+// CELRule is a rule that evaluates a CEL expression for each object selected by its definition.
+type CELRule struct {
+	Client     client.Client
+	Definition CELRuleDefinition
+	program    cel.Program
+}
+
+// NewCELRule creates a CELRule from a validated definition.
+func NewCELRule(c client.Client, definition CELRuleDefinition) (*CELRule, error) {
+	program, err := compile(definition.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", definition.ID, err)
+	}
+
+	return &CELRule{
+		Client:     c,
+		Definition: definition,
+		program:    program,
+	}, nil
+}
+
+func (r *CELRule) ID() string {
+	return r.Definition.ID
+}
+
+func (r *CELRule) Name() string {
+	return r.Definition.Name
+}
+
+func (r *CELRule) Severity() rule.SeverityLevel {
+	return r.Definition.Severity
+}
+
+func (r *CELRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	groupVersion, err := schema.ParseGroupVersion(r.Definition.APIVersion)
+	if err != nil {
+		return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget())), nil
+	}
+
+	objects, err := kubeutils.GetObjects(ctx, r.Client, groupVersion.WithKind(r.Definition.Kind+"List"), "", labels.SelectorFromSet(r.Definition.MatchLabels), 300)
+	if err != nil {
+		return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", r.Definition.Kind+"List"))), nil
+	}
+
+	var namespaces map[string]corev1.Namespace
+	if len(r.Definition.NamespaceMatchLabels) > 0 {
+		if namespaces, err = kubeutils.GetNamespaces(ctx, r.Client); err != nil {
+			return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", "namespaceList"))), nil
+		}
+	}
+
+	var (
+		checkResults      []rule.CheckResult
+		namespaceSelector = labels.SelectorFromSet(r.Definition.NamespaceMatchLabels)
+	)
+	for _, object := range objects {
+		if namespaces != nil {
+			namespace, ok := namespaces[object.GetNamespace()]
+			if !ok || !namespaceSelector.Matches(labels.Set(namespace.Labels)) {
+				continue
+			}
+		}
+
+		target := kubeutils.TargetWithK8sObject(
+			rule.NewTarget(),
+			metav1.TypeMeta{Kind: r.Definition.Kind},
+			metav1.ObjectMeta{Name: object.GetName(), Namespace: object.GetNamespace(), OwnerReferences: object.GetOwnerReferences()},
+		)
+
+		out, _, err := r.program.ContextEval(ctx, map[string]any{ObjectVariable: object.Object})
+		if err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("failed to evaluate expression: %s", err.Error()), target))
+			continue
+		}
+
+		switch compliant, ok := out.Value().(bool); {
+		case !ok:
+			checkResults = append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("expression evaluated to %s instead of bool", out.Type().TypeName()), target))
+		case compliant:
+			checkResults = append(checkResults, rule.PassedCheckResult("Object satisfies the rule expression.", target))
+		default:
+			checkResults = append(checkResults, rule.FailedCheckResult(r.Definition.Message, target))
+		}
+	}
+
+	if len(checkResults) == 0 {
+		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("The cluster does not have any selected objects of kind %s.", r.Definition.Kind), rule.NewTarget())), nil
+	}
+
+	return rule.Result(r, checkResults...), nil
+}
+
+// compile compiles a CEL expression that evaluates an object to a bool.
+func compile(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(ObjectVariable, cel.DynType),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool, but evaluates to %s", outputType)
+	}
+
+	return env.Program(ast, cel.InterruptCheckFrequency(100))
+}
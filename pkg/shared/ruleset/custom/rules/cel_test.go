@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/kubernetes/option"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom/rules"
+)
+
+var _ = Describe("CELRule", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+		definition rules.CELRuleDefinition
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		definition = rules.CELRuleDefinition{
+			ID:         "custom-1",
+			Name:       "Deployments must have at least two replicas.",
+			Severity:   rule.SeverityMedium,
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Expression: "has(object.spec.replicas) && object.spec.replicas >= 2",
+			Message:    "Deployment has less than two replicas.",
+		}
+
+		for _, namespace := range []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "foo"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"team": "bar"}}},
+		} {
+			Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		}
+		for _, deployment := range []*appsv1.Deployment{
+			{ObjectMeta: metav1.ObjectMeta{Name: "one", Namespace: "foo", Labels: map[string]string{"app": "one"}}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "two", Namespace: "bar", Labels: map[string]string{"app": "two"}}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)}},
+		} {
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+		}
+	})
+
+	It("should evaluate the expression for each object", func() {
+		r, err := rules.NewCELRule(fakeClient, definition)
+		Expect(err).NotTo(HaveOccurred())
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.Severity).To(Equal(rule.SeverityMedium))
+		Expect(ruleResult.CheckResults).To(ConsistOf(
+			rule.PassedCheckResult("Object satisfies the rule expression.", rule.NewTarget("kind", "Deployment", "name", "two", "namespace", "bar")),
+			rule.FailedCheckResult("Deployment has less than two replicas.", rule.NewTarget("kind", "Deployment", "name", "one", "namespace", "foo")),
+		))
+	})
+
+	DescribeTable("should only evaluate the selected objects",
+		func(selector option.NamespacedObjectSelector, expectedCheckResults []rule.CheckResult) {
+			definition.NamespacedObjectSelector = selector
+			r, err := rules.NewCELRule(fakeClient, definition)
+			Expect(err).NotTo(HaveOccurred())
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("by labels",
+			option.NamespacedObjectSelector{MatchLabels: map[string]string{"app": "one"}},
+			[]rule.CheckResult{rule.FailedCheckResult("Deployment has less than two replicas.", rule.NewTarget("kind", "Deployment", "name", "one", "namespace", "foo"))},
+		),
+		Entry("by namespace labels",
+			option.NamespacedObjectSelector{NamespaceMatchLabels: map[string]string{"team": "bar"}},
+			[]rule.CheckResult{rule.PassedCheckResult("Object satisfies the rule expression.", rule.NewTarget("kind", "Deployment", "name", "two", "namespace", "bar"))},
+		),
+		Entry("when no object is selected",
+			option.NamespacedObjectSelector{MatchLabels: map[string]string{"app": "three"}},
+			[]rule.CheckResult{rule.PassedCheckResult("The cluster does not have any selected objects of kind Deployment.", rule.NewTarget())},
+		),
+	)
+
+	It("should return errored checks when the expression cannot be evaluated", func() {
+		definition.Expression = "object.spec.paused"
+		r, err := rules.NewCELRule(fakeClient, definition)
+		Expect(err).NotTo(HaveOccurred())
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(2))
+		Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[0].Message).To(ContainSubstring("failed to evaluate expression: no such key: paused"))
+	})
+
+	Describe("#Validate", func() {
+		It("should not return errors for a valid definition", func() {
+			Expect(definition.Validate(field.NewPath("rules").Index(0))).To(BeEmpty())
+		})
+
+		It("should return errors for an invalid definition", func() {
+			definition = rules.CELRuleDefinition{
+				Severity:   "Critical",
+				APIVersion: "apps/v1/foo",
+				Expression: "object.spec.replicas + 1",
+				NamespacedObjectSelector: option.NamespacedObjectSelector{
+					MatchLabels: map[string]string{"foo?": "bar"},
+				},
+			}
+
+			Expect(definition.Validate(field.NewPath("rules").Index(0))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("rules[0].id")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("rules[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("rules[0].severity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("rules[0].apiVersion")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("rules[0].kind")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("rules[0].matchLabels")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("rules[0].expression")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("rules[0].message")})),
+			))
+		})
+	})
+})
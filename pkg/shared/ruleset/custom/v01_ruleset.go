@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package custom
+
+import (
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/custom/rules"
+)
+
+func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error {
+	c, err := r.getClient()
+	if err != nil {
+		return err
+	}
+
+	customRules := make([]rule.Rule, 0, len(r.args.Rules))
+	for _, definition := range r.args.Rules {
+		celRule, err := rules.NewCELRule(c, definition)
+		if err != nil {
+			return err
+		}
+
+		opt, found := ruleOptions[celRule.ID()]
+		if found && opt.Skip != nil && opt.Skip.Enabled {
+			customRules = append(customRules, rule.NewSkipRule(celRule.ID(), celRule.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(celRule.Severity()), rule.SkipRuleWithException(opt.Skip.Exception)))
+			continue
+		}
+		customRules = append(customRules, celRule)
+	}
+
+	return r.AddRules(customRules...)
+}
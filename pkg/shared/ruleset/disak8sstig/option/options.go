@@ -10,6 +10,8 @@ import (
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
 )
 
 // Option that can be validated in order to ensure
@@ -99,6 +101,7 @@ var _ Option = (*Options242414)(nil)
 // AcceptedPods242414 contains option specifications for accepted pods
 type AcceptedPods242414 struct {
 	PodSelector
+	rule.Exception
 	Justification string  `json:"justification" yaml:"justification"`
 	Ports         []int32 `json:"ports" yaml:"ports"`
 }
@@ -109,8 +112,9 @@ func (o Options242414) Validate() field.ErrorList {
 		allErrs  field.ErrorList
 		rootPath = field.NewPath("acceptedPods")
 	)
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, ValidateException(p.Exception, rootPath.Index(i))...)
 		if len(p.Ports) == 0 {
 			allErrs = append(allErrs, field.Required(rootPath.Child("ports"), "must not be empty"))
 		}
@@ -133,6 +137,7 @@ var _ Option = (*Options242415)(nil)
 // AcceptedPods242415 contains option specifications for accepted pods
 type AcceptedPods242415 struct {
 	PodSelector
+	rule.Exception
 	Justification        string   `json:"justification" yaml:"justification"`
 	EnvironmentVariables []string `json:"environmentVariables" yaml:"environmentVariables"`
 }
@@ -143,8 +148,9 @@ func (o Options242415) Validate() field.ErrorList {
 		allErrs  field.ErrorList
 		rootPath = field.NewPath("acceptedPods")
 	)
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, ValidateException(p.Exception, rootPath.Index(i))...)
 		if len(p.EnvironmentVariables) == 0 {
 			allErrs = append(allErrs, field.Required(rootPath.Child("environmentVariables"), "must not be empty"))
 		}
@@ -166,6 +172,15 @@ func ValidateLabelNames(labelNames []string, fldPath *field.Path) field.ErrorLis
 	return allErrs
 }
 
+// ValidateException validates that the metadata of an acceptance is correctly defined
+func ValidateException(exception rule.Exception, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, err := exception.Expiration(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expiresAt"), exception.ExpiresAt, "must be a date in format YYYY-MM-DD"))
+	}
+	return allErrs
+}
+
 // KubeProxyOptions contains options for kube-proxy rules
 type KubeProxyOptions struct {
 	KubeProxyDisabled bool `json:"kubeProxyDisabled" yaml:"kubeProxyDisabled"`
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 )
 
@@ -150,7 +151,8 @@ var _ = Describe("options", func() {
 								"foo": "bar",
 							},
 						},
-						Ports: []int32{0, 100},
+						Exception: rule.Exception{ExpiresAt: "tomorrow"},
+						Ports:     []int32{0, 100},
 					},
 					{
 						PodSelector: option.PodSelector{
@@ -180,6 +182,12 @@ var _ = Describe("options", func() {
 					"BadValue": Equal(int32(-1)),
 					"Detail":   Equal("must not be lower than 0"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("acceptedPods[1].expiresAt"),
+					"BadValue": Equal("tomorrow"),
+					"Detail":   Equal("must be a date in format YYYY-MM-DD"),
+				})),
 			))
 		})
 	})
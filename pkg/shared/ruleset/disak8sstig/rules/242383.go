@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
@@ -41,6 +42,7 @@ var _ option.Option = (*Options242383)(nil)
 
 type AcceptedResources242383 struct {
 	ObjectSelector
+	rule.Exception
 	Justification string `json:"justification" yaml:"justification"`
 	Status        string `json:"status" yaml:"status"`
 }
@@ -50,8 +52,9 @@ func (o Options242383) Validate() field.ErrorList {
 		allErrs  field.ErrorList
 		rootPath = field.NewPath("acceptedResources")
 	)
-	for _, p := range o.AcceptedResources {
+	for i, p := range o.AcceptedResources {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, option.ValidateException(p.Exception, rootPath.Index(i))...)
 		if !slices.Contains([]string{"Passed", "Accepted"}, p.Status) && len(p.Status) > 0 {
 			allErrs = append(allErrs, field.Invalid(rootPath.Child("status"), p.Status, "must be one of 'Passed' or 'Accepted'"))
 		}
@@ -176,12 +179,16 @@ func (r *Rule242383) Run(ctx context.Context) (rule.RuleResult, error) {
 				if len(msg) == 0 {
 					msg = "System resource in system namespaces."
 				}
+				if acceptedResource.Lapsed(time.Now()) {
+					checkResults = append(checkResults, acceptedResource.AcceptedCheckResult(msg, target))
+					continue
+				}
 				checkResults = append(checkResults, rule.PassedCheckResult(msg, target))
 			case "Accepted", "":
 				if len(msg) == 0 {
 					msg = "Accepted user resource in system namespaces."
 				}
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+				checkResults = append(checkResults, acceptedResource.AcceptedCheckResult(msg, target))
 			default:
 				checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("unrecognized status: %s", status), target))
 			}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -41,6 +42,7 @@ var _ option.Option = (*Options242417)(nil)
 
 type AcceptedPods242417 struct {
 	option.PodSelector
+	rule.Exception
 	Justification string `json:"justification" yaml:"justification"`
 	Status        string `json:"status" yaml:"status"`
 }
@@ -51,8 +53,9 @@ func (o Options242417) Validate() field.ErrorList {
 		rootPath = field.NewPath("acceptedPods")
 	)
 
-	for _, p := range o.AcceptedPods {
+	for i, p := range o.AcceptedPods {
 		allErrs = append(allErrs, p.Validate()...)
+		allErrs = append(allErrs, option.ValidateException(p.Exception, rootPath.Index(i))...)
 		if !slices.Contains([]string{"Passed", "Accepted"}, p.Status) && len(p.Status) > 0 {
 			allErrs = append(allErrs, field.Invalid(rootPath.Child("status"), p.Status, "must be one of 'Passed' or 'Accepted'"))
 		}
@@ -124,12 +127,16 @@ func (r *Rule242417) Run(ctx context.Context) (rule.RuleResult, error) {
 				if len(msg) == 0 {
 					msg = "System pod in system namespaces."
 				}
+				if acceptedPod.Lapsed(time.Now()) {
+					checkResults = append(checkResults, acceptedPod.AcceptedCheckResult(msg, target))
+					continue
+				}
 				checkResults = append(checkResults, rule.PassedCheckResult(msg, target))
 			case "Accepted", "":
 				if len(msg) == 0 {
 					msg = "Accepted user pod in system namespaces."
 				}
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+				checkResults = append(checkResults, acceptedPod.AcceptedCheckResult(msg, target))
 			default:
 				checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("unrecognized status: %s", status), target))
 			}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"time"
+)
+
+// RunOptions are options that configure a ruleset [Run].
+type RunOptions struct {
+	// Timeout is the deadline of the whole ruleset run.
+	Timeout time.Duration
+	// RuleTimeouts are the deadlines of single rule runs by rule id.
+	RuleTimeouts map[string]time.Duration
+}
+
+// RunOption is a function that acts on [RunOptions]
+// and is used to configure a ruleset [Run].
+type RunOption func(*RunOptions)
+
+// WithTimeout sets the deadline of the whole ruleset run.
+// A zero timeout means no deadline.
+func WithTimeout(timeout time.Duration) RunOption {
+	return func(o *RunOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithRuleTimeouts sets the deadlines of single rule runs by rule id.
+func WithRuleTimeouts(ruleTimeouts map[string]time.Duration) RunOption {
+	return func(o *RunOptions) {
+		o.RuleTimeouts = ruleTimeouts
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var (
+	_ rule.Rule     = &PluginRule{}
+	_ rule.Severity = &PluginRule{}
+)
+
+// DefaultTimeout is the timeout of plugins that do not set a timeout.
+const DefaultTimeout = time.Minute
+
+// PluginDefinition defines a rule that is implemented by an executable.
+type PluginDefinition struct {
+	// ID is the unique identifier of the rule within the ruleset.
+	ID string `json:"id" yaml:"id"`
+	// Name is the user friendly name of the rule.
+	Name string `json:"name" yaml:"name"`
+	// Severity is the severity of the rule, one of 'Low', 'Medium' or 'High'.
+	Severity rule.SeverityLevel `json:"severity" yaml:"severity"`
+	// Command is the path to the executable of the plugin.
+	Command string `json:"command" yaml:"command"`
+	// Args are the command line arguments of the executable.
+	Args []string `json:"args,omitempty" yaml:"args,omitempty"`
+	// Timeout is the deadline of a plugin run, e.g. "30s". Defaults to one minute.
+	Timeout metav1.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// Validate validates that the plugin definition is correctly defined.
+func (d PluginDefinition) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(d.ID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "must not be empty"))
+	}
+
+	if len(d.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must not be empty"))
+	}
+
+	if !slices.Contains(rule.SeverityLevels(), d.Severity) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), d.Severity, rule.SeverityLevels()))
+	}
+
+	if len(d.Command) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("command"), "must not be empty"))
+	}
+
+	if d.Timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), d.Timeout.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// Request is the input of a plugin that is written as json to its stdin.
+type Request struct {
+	// RuleID is the id of the rule that is run.
+	RuleID string `json:"ruleID"`
+	// KubeconfigPath is the path to the kubeconfig of the provider.
+	// It is empty when the provider uses the in-cluster configuration.
+	KubeconfigPath string `json:"kubeconfigPath"`
+	// Options are the args of the rule options of the rule.
+	Options any `json:"options,omitempty"`
+}
+
+// Response is the output of a plugin that is read as json from its stdout.
+type Response struct {
+	// CheckResults are the check results of the rule.
+	CheckResults []rule.CheckResult `json:"checkResults"`
+}
+
+// PluginRule is a rule that runs an executable and reports its check results.
+type PluginRule struct {
+	Definition     PluginDefinition
+	KubeconfigPath string
+	Options        any
+}
+
+func (r *PluginRule) ID() string {
+	return r.Definition.ID
+}
+
+func (r *PluginRule) Name() string {
+	return r.Definition.Name
+}
+
+func (r *PluginRule) Severity() rule.SeverityLevel {
+	return r.Definition.Severity
+}
+
+func (r *PluginRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	request, err := json.Marshal(Request{
+		RuleID:         r.Definition.ID,
+		KubeconfigPath: r.KubeconfigPath,
+		Options:        r.Options,
+	})
+	if err != nil {
+		return rule.Result(r, rule.ErroredCheckResult(err.Error(), rule.NewTarget())), nil
+	}
+
+	timeout := cmp.Or(r.Definition.Timeout.Duration, DefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.Definition.Command, r.Definition.Args...) // #nosec: G204
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = time.Second
+
+	var (
+		runErr       = cmd.Run()
+		checkResults []rule.CheckResult
+		target       = rule.NewTarget("plugin", r.Definition.Command)
+	)
+
+	if stderrOutput := strings.TrimSpace(stderr.String()); len(stderrOutput) > 0 {
+		checkResults = append(checkResults, rule.ErroredCheckResult(stderrOutput, target))
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return rule.Result(r, append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("plugin did not finish within %s", timeout), target))...), nil
+	case runErr != nil:
+		return rule.Result(r, append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("plugin failed: %s", runErr.Error()), target))...), nil
+	}
+
+	pluginCheckResults, err := parseResponse(stdout.Bytes())
+	if err != nil {
+		return rule.Result(r, append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("invalid plugin output: %s", err.Error()), target))...), nil
+	}
+	checkResults = append(checkResults, pluginCheckResults...)
+
+	if len(checkResults) == 0 {
+		return rule.Result(r, rule.ErroredCheckResult("plugin did not return any check results", target)), nil
+	}
+
+	return rule.Result(r, checkResults...), nil
+}
+
+// parseResponse parses the stdout of a plugin and validates the returned check results.
+func parseResponse(data []byte) ([]rule.CheckResult, error) {
+	var response Response
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	for i, checkResult := range response.CheckResults {
+		if !slices.Contains(rule.Statuses(), checkResult.Status) {
+			return nil, fmt.Errorf("check result %d has unknown status %q", i, checkResult.Status)
+		}
+		if checkResult.Target == nil {
+			response.CheckResults[i].Target = rule.NewTarget()
+		}
+	}
+
+	return response.CheckResults, nil
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/plugin/rules"
+)
+
+var _ = Describe("PluginRule", func() {
+	var (
+		ctx        = context.TODO()
+		dir        string
+		pluginRule *rules.PluginRule
+	)
+
+	writePlugin := func(script string) string {
+		path := filepath.Join(dir, "plugin.sh")
+		Expect(os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0700)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		pluginRule = &rules.PluginRule{
+			Definition: rules.PluginDefinition{
+				ID:       "plugin-1",
+				Name:     "Plugin rule",
+				Severity: rule.SeverityHigh,
+			},
+			KubeconfigPath: "/tmp/kubeconfig",
+			Options:        map[string]any{"foo": "bar"},
+		}
+	})
+
+	It("should pass the request to the plugin and return its check results", func() {
+		pluginRule.Definition.Command = writePlugin(`request=$(cat)
+cat <<EOT
+{"checkResults": [
+  {"status": "Passed", "message": "$(echo "$request" | tr -d '"')", "target": {"name": "foo"}},
+  {"status": "Failed", "message": "bar is not compliant"}
+]}
+EOT
+`)
+
+		ruleResult, err := pluginRule.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.RuleID).To(Equal("plugin-1"))
+		Expect(ruleResult.Severity).To(Equal(rule.SeverityHigh))
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("{ruleID:plugin-1,kubeconfigPath:/tmp/kubeconfig,options:{foo:bar}}", rule.NewTarget("name", "foo")),
+			rule.FailedCheckResult("bar is not compliant", rule.NewTarget()),
+		}))
+	})
+
+	It("should pass the args to the plugin", func() {
+		pluginRule.Definition.Command = writePlugin(`echo "{\"checkResults\": [{\"status\": \"Passed\", \"message\": \"$1 $2\"}]}"`)
+		pluginRule.Definition.Args = []string{"foo", "bar"}
+
+		ruleResult, err := pluginRule.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{rule.PassedCheckResult("foo bar", rule.NewTarget())}))
+	})
+
+	It("should report stderr as errored check", func() {
+		pluginRule.Definition.Command = writePlugin(`echo "something went wrong" >&2
+echo '{"checkResults": [{"status": "Passed", "message": "foo"}]}'`)
+
+		ruleResult, err := pluginRule.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("something went wrong", rule.NewTarget("plugin", pluginRule.Definition.Command)),
+			rule.PassedCheckResult("foo", rule.NewTarget()),
+		}))
+	})
+
+	DescribeTable("should return errored checks",
+		func(script string, timeout time.Duration, expectedCheckResults []rule.CheckResult) {
+			pluginRule.Definition.Command = writePlugin(script)
+			pluginRule.Definition.Timeout = metav1.Duration{Duration: timeout}
+
+			ruleResult, err := pluginRule.Run(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			target := rule.NewTarget("plugin", pluginRule.Definition.Command)
+			for i := range expectedCheckResults {
+				expectedCheckResults[i].Target = target
+			}
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("when the plugin fails",
+			`echo "fatal error" >&2
+exit 3`,
+			time.Duration(0),
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("fatal error", nil),
+				rule.ErroredCheckResult("plugin failed: exit status 3", nil),
+			},
+		),
+		Entry("when the plugin does not finish in time",
+			`sleep 5`,
+			100*time.Millisecond,
+			[]rule.CheckResult{rule.ErroredCheckResult("plugin did not finish within 100ms", nil)},
+		),
+		Entry("when the output is not valid json",
+			`echo "foo"`,
+			time.Duration(0),
+			[]rule.CheckResult{rule.ErroredCheckResult("invalid plugin output: invalid character 'o' in literal false (expecting 'a')", nil)},
+		),
+		Entry("when the output contains unknown statuses",
+			`echo '{"checkResults": [{"status": "Unknown", "message": "foo"}]}'`,
+			time.Duration(0),
+			[]rule.CheckResult{rule.ErroredCheckResult(`invalid plugin output: check result 0 has unknown status "Unknown"`, nil)},
+		),
+		Entry("when the output does not contain check results",
+			`echo '{}'`,
+			time.Duration(0),
+			[]rule.CheckResult{rule.ErroredCheckResult("plugin did not return any check results", nil)},
+		),
+	)
+
+	Describe("#Validate", func() {
+		It("should not return errors for a valid definition", func() {
+			definition := rules.PluginDefinition{ID: "plugin-1", Name: "Plugin rule", Severity: rule.SeverityLow, Command: "/bin/true"}
+			Expect(definition.Validate(field.NewPath("plugins").Index(0))).To(BeEmpty())
+		})
+
+		It("should return errors for an invalid definition", func() {
+			definition := rules.PluginDefinition{Severity: "Critical", Timeout: metav1.Duration{Duration: -time.Second}}
+			Expect(definition.Validate(field.NewPath("plugins").Index(0))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("plugins[0].id")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("plugins[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("plugins[0].severity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("plugins[0].command")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("plugins[0].timeout")})),
+			))
+		})
+	})
+})
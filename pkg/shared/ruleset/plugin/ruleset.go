@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	"github.com/gardener/diki/pkg/shared/ruleset/plugin/rules"
+)
+
+const (
+	// RulesetID is a constant containing the id of the Plugin Ruleset.
+	RulesetID = "plugin"
+	// RulesetName is a constant containing the user-friendly name of the Plugin Ruleset.
+	RulesetName = "Plugins"
+)
+
+var (
+	_ ruleset.Ruleset = &Ruleset{}
+	// SupportedVersions is a list of available versions for the Plugin Ruleset.
+	// Versions are sorted from newest to oldest.
+	SupportedVersions = []string{"v0.1.0"}
+)
+
+// Ruleset implements a ruleset whose rules are external executables defined in its args.
+type Ruleset struct {
+	version        string
+	rules          map[string]rule.Rule
+	KubeconfigPath string
+	numWorkers     int
+	timeout        time.Duration
+	ruleTimeouts   map[string]time.Duration
+	args           Args
+	logger         *slog.Logger
+}
+
+// Args are Ruleset specific arguments.
+type Args struct {
+	// Plugins are the definitions of the rules of the ruleset.
+	Plugins []rules.PluginDefinition `json:"plugins" yaml:"plugins"`
+}
+
+// Validate validates that the args are correctly defined.
+func (a Args) Validate() field.ErrorList {
+	var (
+		allErrs  field.ErrorList
+		rootPath = field.NewPath("plugins")
+		ruleIDs  = map[string]struct{}{}
+	)
+
+	for i, definition := range a.Plugins {
+		allErrs = append(allErrs, definition.Validate(rootPath.Index(i))...)
+		if _, ok := ruleIDs[definition.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(rootPath.Index(i).Child("id"), definition.ID))
+		}
+		ruleIDs[definition.ID] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// New creates a new Ruleset.
+func New(options ...CreateOption) (*Ruleset, error) {
+	r := &Ruleset{
+		rules:      map[string]rule.Rule{},
+		numWorkers: 5,
+	}
+
+	for _, o := range options {
+		o(r)
+	}
+
+	return r, nil
+}
+
+// ID returns the id of the Ruleset.
+func (r *Ruleset) ID() string {
+	return RulesetID
+}
+
+// Name returns the name of the Ruleset.
+func (r *Ruleset) Name() string {
+	return RulesetName
+}
+
+// Version returns the version of the Ruleset.
+func (r *Ruleset) Version() string {
+	return r.version
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig
+// The kubeconfigPath is passed to the plugins, so that they can connect to the cluster of the provider.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, kubeconfigPath string) (*Ruleset, error) {
+	if rulesetConfig.NumWorkers < 0 {
+		return nil, errors.New("ruleset numWorkers should not be a negative number")
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		return nil, errors.New("ruleset timeout should not be a negative duration")
+	}
+
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesetArgs Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return nil, err
+	}
+
+	if err := rulesetArgs.Validate().ToAggregate(); err != nil {
+		return nil, fmt.Errorf("ruleset args error: %w", err)
+	}
+
+	ruleset, err := New(
+		WithVersion(rulesetConfig.Version),
+		WithTimeout(rulesetConfig.Timeout),
+		WithKubeconfigPath(kubeconfigPath),
+		WithArgs(rulesetArgs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if rulesetConfig.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(rulesetConfig.NumWorkers)
+		setNumWorkers(ruleset)
+	}
+
+	ruleOptions := map[string]config.RuleOptionsConfig{}
+	ruleTimeouts := map[string]time.Duration{}
+	for _, opt := range rulesetConfig.RuleOptions {
+		if _, ok := ruleOptions[opt.RuleID]; ok {
+			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
+		}
+
+		if opt.Timeout < 0 {
+			return nil, fmt.Errorf("rule option for rule id: %s has a negative timeout", opt.RuleID)
+		}
+
+		ruleOptions[opt.RuleID] = opt
+		if opt.Timeout > 0 {
+			ruleTimeouts[opt.RuleID] = opt.Timeout
+		}
+	}
+
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	switch rulesetConfig.Version {
+	case "v0.1.0":
+		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
+	}
+
+	return ruleset, nil
+}
+
+// RunRule executes specific known Rule of the Ruleset.
+func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
+	rr, ok := r.rules[id]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
+	}
+
+	return rr.Run(ctx)
+}
+
+// Run executes all known Rules of the Ruleset.
+func (r *Ruleset) Run(ctx context.Context, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(
+		ctx,
+		r,
+		r.rules,
+		hooks,
+		r.numWorkers,
+		r.Logger(),
+		sharedruleset.WithTimeout(r.timeout),
+		sharedruleset.WithRuleTimeouts(r.ruleTimeouts),
+	)
+}
+
+// Rules returns the Rules of the Ruleset sorted by their ids.
+func (r *Ruleset) Rules() []rule.Rule {
+	return slices.SortedFunc(maps.Values(r.rules), func(a, b rule.Rule) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+}
+
+// AddRules adds Rules to the Ruleset.
+func (r *Ruleset) AddRules(rules ...rule.Rule) error {
+	for _, rr := range rules {
+		if _, ok := r.rules[rr.ID()]; ok {
+			return fmt.Errorf("rule with id %s already exists", rr.ID())
+		}
+		r.rules[rr.ID()] = rr
+	}
+	return nil
+}
+
+// Logger returns the Ruleset's logger.
+// If not set it set it to slog.Default().With("ruleset", r.ID(), "version", r.Version() then return it.
+func (r *Ruleset) Logger() *slog.Logger {
+	if r.logger == nil {
+		r.logger = slog.Default().With("ruleset", r.ID(), "version", r.Version())
+	}
+	return r.logger
+}
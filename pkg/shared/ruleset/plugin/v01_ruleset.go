@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/plugin/rules"
+)
+
+func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error {
+	pluginRules := make([]rule.Rule, 0, len(r.args.Plugins))
+	for _, definition := range r.args.Plugins {
+		opt, found := ruleOptions[definition.ID]
+		if found && opt.Skip != nil && opt.Skip.Enabled {
+			pluginRules = append(pluginRules, rule.NewSkipRule(definition.ID, definition.Name, opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(definition.Severity), rule.SkipRuleWithException(opt.Skip.Exception)))
+			continue
+		}
+		pluginRules = append(pluginRules, &rules.PluginRule{
+			Definition:     definition,
+			KubeconfigPath: r.KubeconfigPath,
+			Options:        opt.Args,
+		})
+	}
+
+	return r.AddRules(pluginRules...)
+}
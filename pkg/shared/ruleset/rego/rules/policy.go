@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var (
+	_ rule.Rule     = &PolicyRule{}
+	_ rule.Severity = &PolicyRule{}
+)
+
+const (
+	// DenyRule is the name of the Rego rule whose messages are reported as [rule.Failed] checks.
+	DenyRule = "deny"
+	// WarnRule is the name of the Rego rule whose messages are reported as [rule.Warning] checks.
+	WarnRule = "warn"
+)
+
+// Resource selects the objects that are evaluated by a policy.
+type Resource struct {
+	// APIVersion is the group and version of the objects, e.g. "apps/v1".
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the objects, e.g. "Deployment".
+	Kind string `json:"kind"`
+	// MetadataOnly restricts the input of the policy to the metadata of the objects.
+	MetadataOnly bool `json:"metadataOnly,omitempty"`
+}
+
+// PolicyMetadata is the metadata of a policy that is set in the custom
+// section of the package annotations of a Rego module.
+type PolicyMetadata struct {
+	// ID is the id of the rule. Defaults to the package path of the policy.
+	ID string `json:"id,omitempty"`
+	// Severity is the severity of the rule, one of 'Low', 'Medium' or 'High'.
+	Severity rule.SeverityLevel `json:"severity,omitempty"`
+	// Resources select the objects that are evaluated by the policy.
+	Resources []Resource `json:"resources"`
+}
+
+// PolicyRule is a rule that evaluates the deny and warn rules of
+// a Rego package for each object selected by the policy metadata.
+type PolicyRule struct {
+	Client   client.Client
+	id       string
+	name     string
+	metadata PolicyMetadata
+	queries  map[string]rego.PreparedEvalQuery
+}
+
+// LoadPolicyRules parses the .rego files in the given directory and its subdirectories and
+// returns a rule for each package that defines deny or warn rules. Files ending with _test.rego are ignored.
+// An error is returned if no package defines deny or warn rules.
+// The policies are evaluated without network access, i.e. http.send and net.lookup_ip_addr are not available.
+func LoadPolicyRules(ctx context.Context, c client.Client, dir string) ([]*PolicyRule, error) {
+	modules := map[string]*ast.Module{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".rego" || strings.HasSuffix(path, "_test.rego") {
+			return err
+		}
+
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+
+		module, err := ast.ParseModuleWithOpts(path, string(data), ast.ParserOptions{ProcessAnnotation: true})
+		if err != nil {
+			return err
+		}
+		modules[path] = module
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := ast.NewCompiler().WithCapabilities(offlineCapabilities())
+	if compiler.Compile(modules); compiler.Failed() {
+		return nil, compiler.Errors
+	}
+
+	packages := map[string][]*ast.Module{}
+	for _, path := range slices.Sorted(maps.Keys(modules)) {
+		packagePath := modules[path].Package.Path.String()
+		packages[packagePath] = append(packages[packagePath], modules[path])
+	}
+
+	var policyRules []*PolicyRule
+	for _, packagePath := range slices.Sorted(maps.Keys(packages)) {
+		policyRule, err := newPolicyRule(ctx, c, compiler, packagePath, packages[packagePath])
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", strings.TrimPrefix(packagePath, "data."), err)
+		}
+		if policyRule != nil {
+			policyRules = append(policyRules, policyRule)
+		}
+	}
+
+	if len(policyRules) == 0 {
+		return nil, fmt.Errorf("no package defines deny or warn rules in %s", dir)
+	}
+	return policyRules, nil
+}
+
+// newPolicyRule returns a rule for the modules of a package or nil if the package does not define deny or warn rules.
+func newPolicyRule(ctx context.Context, c client.Client, compiler *ast.Compiler, packagePath string, modules []*ast.Module) (*PolicyRule, error) {
+	policyRule := &PolicyRule{
+		Client:  c,
+		id:      strings.TrimPrefix(packagePath, "data."),
+		queries: map[string]rego.PreparedEvalQuery{},
+	}
+
+	for _, module := range modules {
+		for _, annotations := range module.Annotations {
+			if annotations.Scope != "package" {
+				continue
+			}
+			if len(annotations.Title) > 0 {
+				policyRule.name = annotations.Title
+			}
+			if len(annotations.Custom) > 0 {
+				data, err := json.Marshal(annotations.Custom)
+				if err != nil {
+					return nil, err
+				}
+				if err := json.Unmarshal(data, &policyRule.metadata); err != nil {
+					return nil, fmt.Errorf("invalid custom annotations: %w", err)
+				}
+			}
+		}
+
+		for _, r := range module.Rules {
+			name := r.Head.Ref().String()
+			if name != DenyRule && name != WarnRule {
+				continue
+			}
+			if _, ok := policyRule.queries[name]; ok {
+				continue
+			}
+
+			query, err := rego.New(
+				rego.Compiler(compiler),
+				rego.Query(packagePath+"."+name),
+				rego.Capabilities(offlineCapabilities()),
+				rego.StrictBuiltinErrors(true),
+			).PrepareForEval(ctx)
+			if err != nil {
+				return nil, err
+			}
+			policyRule.queries[name] = query
+		}
+	}
+
+	if len(policyRule.queries) == 0 {
+		return nil, nil
+	}
+
+	policyRule.id = cmp.Or(policyRule.metadata.ID, policyRule.id)
+	policyRule.name = cmp.Or(policyRule.name, policyRule.id)
+	if err := policyRule.metadata.validate(); err != nil {
+		return nil, err
+	}
+	return policyRule, nil
+}
+
+func (m PolicyMetadata) validate() error {
+	var errs []error
+	if len(m.Severity) > 0 && !slices.Contains(rule.SeverityLevels(), m.Severity) {
+		errs = append(errs, fmt.Errorf("severity %s is not one of %v", m.Severity, rule.SeverityLevels()))
+	}
+
+	if len(m.Resources) == 0 {
+		errs = append(errs, errors.New("resources must not be empty"))
+	}
+
+	for _, resource := range m.Resources {
+		if _, err := schema.ParseGroupVersion(resource.APIVersion); err != nil || len(resource.APIVersion) == 0 {
+			errs = append(errs, fmt.Errorf("resource apiVersion %s must be a valid group version", resource.APIVersion))
+		}
+		if len(resource.Kind) == 0 {
+			errs = append(errs, errors.New("resource kind must not be empty"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *PolicyRule) ID() string {
+	return r.id
+}
+
+func (r *PolicyRule) Name() string {
+	return r.name
+}
+
+func (r *PolicyRule) Severity() rule.SeverityLevel {
+	return r.metadata.Severity
+}
+
+func (r *PolicyRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	var checkResults []rule.CheckResult
+
+	for _, resource := range r.metadata.Resources {
+		objects, err := r.listObjects(ctx, resource)
+		if err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", resource.Kind+"List")))
+			continue
+		}
+
+		for _, object := range objects {
+			objectMeta := metav1.ObjectMeta{}
+			if metadata, ok := object["metadata"].(map[string]any); ok {
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(metadata, &objectMeta); err != nil {
+					checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", resource.Kind)))
+					continue
+				}
+			}
+			target := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: resource.Kind}, objectMeta)
+
+			objectCheckResults, err := r.evaluate(ctx, object, target)
+			if err != nil {
+				checkResults = append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("failed to evaluate policy: %s", err.Error()), target))
+				continue
+			}
+			checkResults = append(checkResults, objectCheckResults...)
+		}
+	}
+
+	if len(checkResults) == 0 {
+		return rule.Result(r, rule.PassedCheckResult("The cluster does not have any objects evaluated by the policy.", rule.NewTarget())), nil
+	}
+
+	return rule.Result(r, checkResults...), nil
+}
+
+// evaluate returns a check result for each deny and warn message of the policy for the given object.
+// A passed check result is returned when there are no messages.
+func (r *PolicyRule) evaluate(ctx context.Context, object map[string]any, target rule.Target) ([]rule.CheckResult, error) {
+	var checkResults []rule.CheckResult
+	for _, name := range []string{DenyRule, WarnRule} {
+		query, ok := r.queries[name]
+		if !ok {
+			continue
+		}
+
+		resultSet, err := query.Eval(ctx, rego.EvalInput(object))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages(resultSet) {
+			if name == DenyRule {
+				checkResults = append(checkResults, rule.FailedCheckResult(message, target))
+			} else {
+				checkResults = append(checkResults, rule.WarningCheckResult(message, target))
+			}
+		}
+	}
+
+	if len(checkResults) == 0 {
+		return []rule.CheckResult{rule.PassedCheckResult("Object complies with the policy.", target)}, nil
+	}
+	return checkResults, nil
+}
+
+// listObjects returns the objects of the resource as the input of the policy.
+func (r *PolicyRule) listObjects(ctx context.Context, resource Resource) ([]map[string]any, error) {
+	groupVersion, err := schema.ParseGroupVersion(resource.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	listGVK := groupVersion.WithKind(resource.Kind + "List")
+
+	var objects []map[string]any
+	if !resource.MetadataOnly {
+		fullObjects, err := kubeutils.GetObjects(ctx, r.Client, listGVK, "", labels.NewSelector(), 300)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range fullObjects {
+			objects = append(objects, object.Object)
+		}
+		return objects, nil
+	}
+
+	partialObjects, err := kubeutils.GetObjectsMetadata(ctx, r.Client, listGVK, "", labels.NewSelector(), 300)
+	if err != nil {
+		return nil, err
+	}
+	for _, partialObject := range partialObjects {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&partialObject)
+		if err != nil {
+			return nil, err
+		}
+		object["apiVersion"], object["kind"] = resource.APIVersion, resource.Kind
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// messages returns the messages of a deny or warn rule. Messages can be strings
+// or objects with a msg field. Other values are returned in json format.
+func messages(resultSet rego.ResultSet) []string {
+	var messages []string
+	for _, result := range resultSet {
+		for _, expression := range result.Expressions {
+			values, ok := expression.Value.([]any)
+			if !ok {
+				values = []any{expression.Value}
+			}
+
+			for _, value := range values {
+				if msg, ok := value.(string); ok {
+					messages = append(messages, msg)
+					continue
+				}
+				if object, ok := value.(map[string]any); ok {
+					if msg, ok := object["msg"].(string); ok {
+						messages = append(messages, msg)
+						continue
+					}
+				}
+				data, _ := json.Marshal(value)
+				messages = append(messages, string(data))
+			}
+		}
+	}
+
+	slices.Sort(messages)
+	return messages
+}
+
+// offlineCapabilities returns the capabilities of the current OPA version without builtins that access the network.
+func offlineCapabilities() *ast.Capabilities {
+	capabilities := ast.CapabilitiesForThisVersion()
+	capabilities.Builtins = slices.DeleteFunc(capabilities.Builtins, func(builtin *ast.Builtin) bool {
+		return builtin.Name == ast.HTTPSend.Name || builtin.Name == ast.NetLookupIPAddr.Name
+	})
+	capabilities.AllowNet = []string{}
+	return capabilities
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/rego/rules"
+)
+
+const replicasPolicy = `# METADATA
+# title: Deployments must have at least two replicas.
+# custom:
+#   id: replicas
+#   severity: Medium
+#   resources:
+#   - apiVersion: apps/v1
+#     kind: Deployment
+package diki.replicas
+
+deny contains msg if {
+	input.spec.replicas < 2
+	msg := "Deployment has less than two replicas."
+}
+
+warn contains {"msg": "Deployment has exactly two replicas."} if {
+	input.spec.replicas == 2
+}
+`
+
+const labelsPolicy = `# METADATA
+# custom:
+#   resources:
+#   - apiVersion: v1
+#     kind: Namespace
+#     metadataOnly: true
+package diki.labels
+
+deny contains sprintf("Namespace does not have label %s.", [label]) if {
+	some label in ["team", "tier"]
+	not input.metadata.labels[label]
+}
+
+helper := true
+`
+
+var _ = Describe("PolicyRule", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+		dir        string
+	)
+
+	writePolicy := func(name, policy string) {
+		Expect(os.WriteFile(filepath.Join(dir, name), []byte(policy), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		for _, namespace := range []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "foo", "tier": "one"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"team": "bar"}}},
+		} {
+			Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		}
+		for _, deployment := range []*appsv1.Deployment{
+			{ObjectMeta: metav1.ObjectMeta{Name: "one", Namespace: "foo"}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "two", Namespace: "bar"}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "three", Namespace: "bar"}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)}},
+		} {
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+		}
+	})
+
+	It("should load a rule for each package with deny or warn rules", func() {
+		writePolicy("replicas.rego", replicasPolicy)
+		Expect(os.Mkdir(filepath.Join(dir, "namespaces"), 0700)).To(Succeed())
+		writePolicy(filepath.Join("namespaces", "labels.rego"), labelsPolicy)
+		writePolicy("replicas_test.rego", "package diki.replicas_test\n\ndeny := invalid")
+		writePolicy("lib.rego", "package diki.lib\n\nis_prod if input.metadata.labels.tier == \"prod\"\n")
+		writePolicy("README.md", "not a policy")
+
+		policyRules, err := rules.LoadPolicyRules(ctx, fakeClient, dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyRules).To(HaveLen(2))
+
+		Expect(policyRules[0].ID()).To(Equal("diki.labels"))
+		Expect(policyRules[0].Name()).To(Equal("diki.labels"))
+		Expect(policyRules[0].Severity()).To(BeEmpty())
+		Expect(policyRules[1].ID()).To(Equal("replicas"))
+		Expect(policyRules[1].Name()).To(Equal("Deployments must have at least two replicas."))
+		Expect(policyRules[1].Severity()).To(Equal(rule.SeverityMedium))
+	})
+
+	It("should map deny and warn messages to check results", func() {
+		writePolicy("replicas.rego", replicasPolicy)
+
+		policyRules, err := rules.LoadPolicyRules(ctx, fakeClient, dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyRules).To(HaveLen(1))
+
+		ruleResult, err := policyRules[0].Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.Severity).To(Equal(rule.SeverityMedium))
+		Expect(ruleResult.CheckResults).To(ConsistOf(
+			rule.FailedCheckResult("Deployment has less than two replicas.", rule.NewTarget("kind", "Deployment", "name", "one", "namespace", "foo")),
+			rule.WarningCheckResult("Deployment has exactly two replicas.", rule.NewTarget("kind", "Deployment", "name", "two", "namespace", "bar")),
+			rule.PassedCheckResult("Object complies with the policy.", rule.NewTarget("kind", "Deployment", "name", "three", "namespace", "bar")),
+		))
+	})
+
+	It("should evaluate the metadata of objects", func() {
+		writePolicy("labels.rego", labelsPolicy)
+
+		policyRules, err := rules.LoadPolicyRules(ctx, fakeClient, dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyRules).To(HaveLen(1))
+
+		ruleResult, err := policyRules[0].Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(ConsistOf(
+			rule.PassedCheckResult("Object complies with the policy.", rule.NewTarget("kind", "Namespace", "name", "foo")),
+			rule.FailedCheckResult("Namespace does not have label tier.", rule.NewTarget("kind", "Namespace", "name", "bar")),
+		))
+	})
+
+	It("should return errored checks when the policy cannot be evaluated", func() {
+		writePolicy("replicas.rego", `# METADATA
+# custom:
+#   resources:
+#   - apiVersion: apps/v1
+#     kind: Deployment
+package diki.replicas
+
+deny contains msg if {
+	msg := sprintf("Deployment %s has %d replicas.", [input.metadata.name, to_number("invalid")])
+}
+`)
+
+		policyRules, err := rules.LoadPolicyRules(ctx, fakeClient, dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		ruleResult, err := policyRules[0].Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(3))
+		Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[0].Message).To(ContainSubstring("failed to evaluate policy"))
+	})
+
+	DescribeTable("should return an error for invalid policies",
+		func(policy, expectedErr string) {
+			writePolicy("policy.rego", policy)
+
+			_, err := rules.LoadPolicyRules(ctx, fakeClient, dir)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("when the policy cannot be parsed",
+			"package diki.foo\n\ndeny contains",
+			"rego_parse_error",
+		),
+		Entry("when the policy does not select resources",
+			"package diki.foo\n\ndeny contains \"foo\" if true\n",
+			"package diki.foo: resources must not be empty",
+		),
+		Entry("when the severity is not supported",
+			"# METADATA\n# custom:\n#   severity: Critical\n#   resources:\n#   - apiVersion: v1\n#     kind: Pod\npackage diki.foo\n\ndeny contains \"foo\" if true\n",
+			"severity Critical is not one of",
+		),
+		Entry("when no package defines deny or warn rules",
+			"package diki.lib\n\nis_prod if input.metadata.labels.tier == \"prod\"\n",
+			"no package defines deny or warn rules in",
+		),
+		Entry("when the policy accesses the network",
+			"# METADATA\n# custom:\n#   resources:\n#   - apiVersion: v1\n#     kind: Pod\npackage diki.foo\n\ndeny contains \"foo\" if http.send({\"method\": \"get\", \"url\": \"https://example.com\"})\n",
+			"undefined function http.send",
+		),
+	)
+})
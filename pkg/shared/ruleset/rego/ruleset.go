@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+const (
+	// RulesetID is a constant containing the id of the Rego Ruleset.
+	RulesetID = "rego"
+	// RulesetName is a constant containing the user-friendly name of the Rego Ruleset.
+	RulesetName = "Rego Policies"
+)
+
+var (
+	_ ruleset.Ruleset = &Ruleset{}
+	// SupportedVersions is a list of available versions for the Rego Ruleset.
+	// Versions are sorted from newest to oldest.
+	SupportedVersions = []string{"v0.1.0"}
+)
+
+// Ruleset implements a ruleset whose rules are Rego policies loaded from a directory.
+type Ruleset struct {
+	version        string
+	rules          map[string]rule.Rule
+	Config         *rest.Config
+	numWorkers     int
+	timeout        time.Duration
+	ruleTimeouts   map[string]time.Duration
+	args           Args
+	logger         *slog.Logger
+	inspectionOnly bool
+}
+
+// Args are Ruleset specific arguments.
+type Args struct {
+	// Path is the directory from which the .rego files of the policies are loaded.
+	Path string `json:"path" yaml:"path"`
+}
+
+// New creates a new Ruleset.
+func New(options ...CreateOption) (*Ruleset, error) {
+	r := &Ruleset{
+		rules:      map[string]rule.Rule{},
+		numWorkers: 5,
+	}
+
+	for _, o := range options {
+		o(r)
+	}
+
+	return r, nil
+}
+
+// ID returns the id of the Ruleset.
+func (r *Ruleset) ID() string {
+	return RulesetID
+}
+
+// Name returns the name of the Ruleset.
+func (r *Ruleset) Name() string {
+	return RulesetName
+}
+
+// Version returns the version of the Ruleset.
+func (r *Ruleset) Version() string {
+	return r.version
+}
+
+// RuleArgs returns zero values of the argument types accepted by the rules of the given
+// ruleset version by rule ID. The rules of the Rego Ruleset do not accept arguments.
+func RuleArgs(_ string) map[string]any {
+	return nil
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig.
+// The given options are applied before the rules are registered.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, options ...CreateOption) (*Ruleset, error) {
+	if rulesetConfig.NumWorkers < 0 {
+		return nil, errors.New("ruleset numWorkers should not be a negative number")
+	}
+
+	if rulesetConfig.Timeout < 0 {
+		return nil, errors.New("ruleset timeout should not be a negative duration")
+	}
+
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesetArgs Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return nil, err
+	}
+
+	if len(rulesetArgs.Path) == 0 {
+		return nil, errors.New("ruleset args path must not be empty")
+	}
+
+	ruleset, err := New(append([]CreateOption{
+		WithVersion(rulesetConfig.Version),
+		WithTimeout(rulesetConfig.Timeout),
+		WithConfig(managedConfig),
+		WithArgs(rulesetArgs),
+	}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if rulesetConfig.NumWorkers > 0 {
+		setNumWorkers := WithNumberOfWorkers(rulesetConfig.NumWorkers)
+		setNumWorkers(ruleset)
+	}
+
+	ruleOptions := map[string]config.RuleOptionsConfig{}
+	ruleTimeouts := map[string]time.Duration{}
+	for _, opt := range rulesetConfig.RuleOptions {
+		if _, ok := ruleOptions[opt.RuleID]; ok {
+			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
+		}
+
+		if opt.Timeout < 0 {
+			return nil, fmt.Errorf("rule option for rule id: %s has a negative timeout", opt.RuleID)
+		}
+
+		ruleOptions[opt.RuleID] = opt
+		if opt.Timeout > 0 {
+			ruleTimeouts[opt.RuleID] = opt.Timeout
+		}
+	}
+
+	setRuleTimeouts := WithRuleTimeouts(ruleTimeouts)
+	setRuleTimeouts(ruleset)
+
+	switch rulesetConfig.Version {
+	case "v0.1.0":
+		if err := ruleset.registerV01Rules(ruleOptions); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
+	}
+
+	return ruleset, nil
+}
+
+// getClient creates a client from the Config of the Ruleset.
+// It returns a nil client if the Ruleset is created for inspection only.
+func (r *Ruleset) getClient() (client.Client, error) {
+	if r.inspectionOnly {
+		return nil, nil
+	}
+	if r.Config == nil {
+		return nil, errors.New("cluster config is nil")
+	}
+	return client.New(r.Config, client.Options{})
+}
+
+// RunRule executes specific known Rule of the Ruleset.
+func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
+	rr, ok := r.rules[id]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
+	}
+
+	return rr.Run(ctx)
+}
+
+// Run executes all known Rules of the Ruleset.
+func (r *Ruleset) Run(ctx context.Context, hooks ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(
+		ctx,
+		r,
+		r.rules,
+		hooks,
+		r.numWorkers,
+		r.Logger(),
+		sharedruleset.WithTimeout(r.timeout),
+		sharedruleset.WithRuleTimeouts(r.ruleTimeouts),
+	)
+}
+
+// Rules returns the Rules of the Ruleset sorted by their ids.
+func (r *Ruleset) Rules() []rule.Rule {
+	return slices.SortedFunc(maps.Values(r.rules), func(a, b rule.Rule) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+}
+
+// AddRules adds Rules to the Ruleset.
+func (r *Ruleset) AddRules(rules ...rule.Rule) error {
+	for _, rr := range rules {
+		if _, ok := r.rules[rr.ID()]; ok {
+			return fmt.Errorf("rule with id %s already exists", rr.ID())
+		}
+		r.rules[rr.ID()] = rr
+	}
+	return nil
+}
+
+// Logger returns the Ruleset's logger.
+// If not set it set it to slog.Default().With("ruleset", r.ID(), "version", r.Version() then return it.
+func (r *Ruleset) Logger() *slog.Logger {
+	if r.logger == nil {
+		r.logger = slog.Default().With("ruleset", r.ID(), "version", r.Version())
+	}
+	return r.logger
+}
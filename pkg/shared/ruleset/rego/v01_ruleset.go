@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/rego/rules"
+)
+
+func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error {
+	c, err := r.getClient()
+	if err != nil {
+		return err
+	}
+
+	policyRules, err := rules.LoadPolicyRules(context.Background(), c, r.args.Path)
+	if err != nil {
+		return fmt.Errorf("failed to load policies from %s: %w", r.args.Path, err)
+	}
+
+	regoRules := make([]rule.Rule, 0, len(policyRules))
+	for _, policyRule := range policyRules {
+		opt, found := ruleOptions[policyRule.ID()]
+		if found && opt.Skip != nil && opt.Skip.Enabled {
+			regoRules = append(regoRules, rule.NewSkipRule(policyRule.ID(), policyRule.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(policyRule.Severity()), rule.SkipRuleWithException(opt.Skip.Exception)))
+			continue
+		}
+		regoRules = append(regoRules, policyRule)
+	}
+
+	return r.AddRules(regoRules...)
+}
@@ -8,7 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
@@ -16,17 +18,36 @@ import (
 )
 
 // Run is a sample implementation for a [ruleset.Ruleset].
+// Rules that return an error are reported with a single [rule.Errored] check result
+// containing the error message. Rules that exceed their deadline are reported with
+// a single [rule.Errored] check result with a target marking them as timed out.
+// The returned result holds the results of all rules that were run,
+// even when the returned error is not nil. The given [ruleset.RunHooks] select the rules
+// that are run, resume rule results of a previous run and modify and handle all rule results.
 func Run(
 	ctx context.Context,
 	r ruleset.Ruleset,
 	rules map[string]rule.Rule,
+	hooks ruleset.RunHooks,
 	numWorkers int,
 	log provider.Logger,
+	options ...RunOption,
 ) (ruleset.RulesetResult, error) {
 	if len(rules) == 0 {
 		return ruleset.RulesetResult{}, fmt.Errorf("no rules are registered in the ruleset")
 	}
 
+	opts := &RunOptions{}
+	for _, o := range options {
+		o(opts)
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
 	workers := 1
 	if numWorkers > 0 {
 		workers = numWorkers
@@ -39,30 +60,94 @@ func Run(
 		RuleResults:    make([]rule.RuleResult, 0, len(rules)),
 	}
 
+	if hooks.Matcher != nil {
+		matchedRules := make(map[string]rule.Rule, len(rules))
+		for id, rr := range rules {
+			if hooks.Matcher(rr) {
+				matchedRules[id] = rr
+			}
+		}
+		rules = matchedRules
+		if len(rules) == 0 {
+			log.Info("no rules match the rule filter")
+			return result, nil
+		}
+	}
+
+	modifyResult := func(ruleResult rule.RuleResult) rule.RuleResult { return ruleResult }
+	if hooks.Modifier != nil {
+		modifyResult = func(ruleResult rule.RuleResult) rule.RuleResult {
+			return hooks.Modifier(r.ID(), r.Version(), ruleResult)
+		}
+	}
+
+	handleResult := func(ruleResult rule.RuleResult, timedOut, resumed bool) {
+		if hooks.Handler == nil {
+			return
+		}
+		res := ruleset.RulesetResult{
+			RulesetName:    r.Name(),
+			RulesetID:      r.ID(),
+			RulesetVersion: r.Version(),
+			RuleResults:    []rule.RuleResult{ruleResult},
+		}
+		if timedOut {
+			res.TimedOutRules = []string{ruleResult.RuleID}
+		}
+		if resumed {
+			res.ResumedRules = []string{ruleResult.RuleID}
+		}
+		hooks.Handler(res)
+	}
+
+	rulesToRun := rules
+	if hooks.Resumed != nil {
+		rulesToRun = make(map[string]rule.Rule, len(rules))
+		for id, rr := range rules {
+			res, ok := hooks.Resumed(r.ID(), r.Version(), rr.ID())
+			if !ok {
+				rulesToRun[id] = rr
+				continue
+			}
+			res = modifyResult(res)
+			result.RuleResults = append(result.RuleResults, res)
+			result.ResumedRules = append(result.ResumedRules, rr.ID())
+			handleResult(res, false, true)
+		}
+		slices.Sort(result.ResumedRules)
+		if len(result.ResumedRules) > 0 {
+			log.Info("resuming rule results from previous run", "number_of_resumed_rules", len(result.ResumedRules))
+		}
+	}
+
 	type run struct {
-		result rule.RuleResult
-		err    error
+		result   rule.RuleResult
+		err      error
+		timedOut bool
 	}
 
 	rulesCh := make(chan rule.Rule)
 	resultCh := make(chan run)
 
 	wg := sync.WaitGroup{}
-	log.Info("starting ruleset run", "number_of_rules", len(rules), "number_of_workers", workers)
+	log.Info("starting ruleset run", "number_of_rules", len(rulesToRun), "number_of_workers", workers)
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
 		go func() {
 			for r := range rulesCh {
 				log.Info("starting rule run", "rule_id", r.ID())
-				res, err := r.Run(ctx)
+				res, timedOut, err := runRule(ctx, r, opts.RuleTimeouts[r.ID()])
 				res.RuleID = r.ID()
 				res.RuleName = r.Name()
+				if severity, ok := r.(rule.Severity); ok && len(res.Severity) == 0 {
+					res.Severity = severity.Severity()
+				}
 
 				if len(res.CheckResults) == 0 {
 					res.CheckResults = append(res.CheckResults, rule.WarningCheckResult("Rule run did not report any status.", rule.NewTarget()))
 				}
 
-				resultCh <- run{result: res, err: err}
+				resultCh <- run{result: res, err: err, timedOut: timedOut}
 			}
 			wg.Done()
 		}()
@@ -70,7 +155,7 @@ func Run(
 
 	go func() {
 		defer close(rulesCh)
-		for _, r := range rules {
+		for _, r := range rulesToRun {
 			select {
 			case <-ctx.Done():
 				return
@@ -85,28 +170,91 @@ func Run(
 		close(resultCh)
 	}()
 
-	var err error
-	resultCount := 0
+	var (
+		err         error
+		resultCount = 0
+	)
 	for run := range resultCh {
 		resultCount++
-		remaining := len(rules) - resultCount
+		remaining := len(rulesToRun) - resultCount
 		finishMsg := "finished rule run"
-		if run.err != nil {
+		switch {
+		case run.timedOut:
+			log.Error(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining, "error", "timed out")
+			err = errors.Join(err, fmt.Errorf("rule with id %s timed out", run.result.RuleID))
+			run.result.CheckResults = []rule.CheckResult{timedOutCheckResult()}
+			result.TimedOutRules = append(result.TimedOutRules, run.result.RuleID)
+		case run.err != nil:
 			log.Error(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining, "error", run.err)
 			err = errors.Join(err, fmt.Errorf("rule with id %s errored: %w", run.result.RuleID, run.err))
-		} else {
+			run.result.CheckResults = []rule.CheckResult{rule.ErroredCheckResult(run.err.Error(), rule.NewTarget())}
+		default:
 			log.Info(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining)
-			result.RuleResults = append(result.RuleResults, run.result)
 		}
+		run.result = modifyResult(run.result)
+		result.RuleResults = append(result.RuleResults, run.result)
+		handleResult(run.result, run.timedOut, false)
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// rules that were not started before the deadline of the run also hit their deadline
+		for _, r := range rules {
+			if slices.ContainsFunc(result.RuleResults, func(ruleResult rule.RuleResult) bool {
+				return ruleResult.RuleID == r.ID()
+			}) {
+				continue
+			}
+
+			res := modifyResult(rule.Result(r, timedOutCheckResult()))
+			result.RuleResults = append(result.RuleResults, res)
+			result.TimedOutRules = append(result.TimedOutRules, r.ID())
+			handleResult(res, true, false)
+		}
+	}
+	slices.Sort(result.TimedOutRules)
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return result, errors.Join(ctxErr, err)
+	}
+
+	return result, err
+}
+
+// runRule runs a rule with an optional timeout. It returns as soon as the deadline
+// of the rule is exceeded, even if the rule itself does not respect the context.
+func runRule(ctx context.Context, r rule.Rule, timeout time.Duration) (rule.RuleResult, bool, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	if err := ctx.Err(); err != nil {
-		return ruleset.RulesetResult{}, err
+	type run struct {
+		result rule.RuleResult
+		err    error
 	}
 
-	// TODO: maybe return both result and err
-	if err != nil {
-		return ruleset.RulesetResult{}, err
+	// the channel is buffered so that rules which exceed their deadline do not block forever
+	runCh := make(chan run, 1)
+	go func() {
+		res, err := r.Run(ctx)
+		runCh <- run{result: res, err: err}
+	}()
+
+	select {
+	case run := <-runCh:
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return rule.RuleResult{}, true, nil
+		}
+		return run.result, false, run.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return rule.RuleResult{}, true, nil
+		}
+		return rule.RuleResult{}, false, ctx.Err()
 	}
-	return result, nil
+}
+
+func timedOutCheckResult() rule.CheckResult {
+	return rule.ErroredCheckResult("Rule run exceeded its deadline.", rule.NewTarget("timedOut", "true"))
 }
@@ -0,0 +1,343 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+var _ rule.Rule = &fakeRule{}
+
+type fakeRule struct {
+	id       string
+	severity rule.SeverityLevel
+	run      func(ctx context.Context) (rule.RuleResult, error)
+}
+
+func (r *fakeRule) ID() string {
+	return r.id
+}
+
+func (r *fakeRule) Name() string {
+	return "Fake rule " + r.id
+}
+
+func (r *fakeRule) Severity() rule.SeverityLevel {
+	return r.severity
+}
+
+func (r *fakeRule) Run(ctx context.Context) (rule.RuleResult, error) {
+	return r.run(ctx)
+}
+
+var _ ruleset.Ruleset = &fakeRuleset{}
+
+type fakeRuleset struct{}
+
+func (r *fakeRuleset) ID() string {
+	return "fake"
+}
+
+func (r *fakeRuleset) Name() string {
+	return "Fake"
+}
+
+func (r *fakeRuleset) Version() string {
+	return "v1"
+}
+
+func (r *fakeRuleset) Run(context.Context, ruleset.RunHooks) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+
+func (r *fakeRuleset) RunRule(context.Context, string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+
+var _ = Describe("ruleset", func() {
+	Describe("#Run", func() {
+		var (
+			ctx    context.Context
+			logger *slog.Logger
+			rs     *fakeRuleset
+			hooks  ruleset.RunHooks
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+			rs = &fakeRuleset{}
+			hooks = ruleset.RunHooks{}
+		})
+
+		It("should return an error when no rules are registered", func() {
+			_, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{}, hooks, 1, logger)
+
+			Expect(err).To(MatchError("no rules are registered in the ruleset"))
+		})
+
+		It("should return partial results and convert errored rules to errored checks", func() {
+			passing := &fakeRule{id: "1", severity: rule.SeverityLow}
+			passing.run = func(context.Context) (rule.RuleResult, error) {
+				return rule.Result(passing, rule.PassedCheckResult("foo", rule.NewTarget())), nil
+			}
+			erroring := &fakeRule{id: "2", severity: rule.SeverityHigh}
+			erroring.run = func(context.Context) (rule.RuleResult, error) {
+				return rule.RuleResult{}, errors.New("bar")
+			}
+
+			res, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{"1": passing, "2": erroring}, hooks, 2, logger)
+
+			Expect(err).To(MatchError("rule with id 2 errored: bar"))
+			Expect(res.RulesetID).To(Equal("fake"))
+			Expect(res.RulesetVersion).To(Equal("v1"))
+			Expect(res.RuleResults).To(ConsistOf(
+				rule.RuleResult{
+					RuleID:       "1",
+					RuleName:     "Fake rule 1",
+					Severity:     rule.SeverityLow,
+					CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())},
+				},
+				rule.RuleResult{
+					RuleID:       "2",
+					RuleName:     "Fake rule 2",
+					Severity:     rule.SeverityHigh,
+					CheckResults: []rule.CheckResult{rule.ErroredCheckResult("bar", rule.NewTarget())},
+				},
+			))
+		})
+
+		It("should report rules that exceed their timeout as timed out and continue with the other rules", func() {
+			passing := &fakeRule{id: "1"}
+			passing.run = func(context.Context) (rule.RuleResult, error) {
+				return rule.Result(passing, rule.PassedCheckResult("foo", rule.NewTarget())), nil
+			}
+			hanging := &fakeRule{id: "2", severity: rule.SeverityMedium}
+			hanging.run = func(context.Context) (rule.RuleResult, error) {
+				// does not respect the context
+				time.Sleep(time.Second)
+				return rule.Result(hanging, rule.PassedCheckResult("bar", rule.NewTarget())), nil
+			}
+
+			res, err := sharedruleset.Run(
+				ctx,
+				rs,
+				map[string]rule.Rule{"1": passing, "2": hanging},
+				hooks,
+				1,
+				logger,
+				sharedruleset.WithRuleTimeouts(map[string]time.Duration{"2": 10 * time.Millisecond}),
+			)
+
+			Expect(err).To(MatchError("rule with id 2 timed out"))
+			Expect(res.TimedOutRules).To(Equal([]string{"2"}))
+			Expect(res.RuleResults).To(ConsistOf(
+				rule.RuleResult{
+					RuleID:       "1",
+					RuleName:     "Fake rule 1",
+					CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())},
+				},
+				rule.RuleResult{
+					RuleID:       "2",
+					RuleName:     "Fake rule 2",
+					Severity:     rule.SeverityMedium,
+					CheckResults: []rule.CheckResult{rule.ErroredCheckResult("Rule run exceeded its deadline.", rule.NewTarget("timedOut", "true"))},
+				},
+			))
+		})
+
+		It("should report all unfinished rules as timed out when the ruleset timeout is exceeded", func() {
+			rules := map[string]rule.Rule{}
+			for _, id := range []string{"1", "2", "3"} {
+				r := &fakeRule{id: id}
+				r.run = func(ctx context.Context) (rule.RuleResult, error) {
+					<-ctx.Done()
+					return rule.RuleResult{}, ctx.Err()
+				}
+				rules[id] = r
+			}
+
+			res, err := sharedruleset.Run(ctx, rs, rules, hooks, 1, logger, sharedruleset.WithTimeout(10*time.Millisecond))
+
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(res.TimedOutRules).To(Equal([]string{"1", "2", "3"}))
+			Expect(res.RuleResults).To(HaveLen(3))
+			for _, ruleResult := range res.RuleResults {
+				Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{rule.ErroredCheckResult("Rule run exceeded its deadline.", rule.NewTarget("timedOut", "true"))}))
+			}
+		})
+
+		It("should call the rule result handler for each rule", func() {
+			rules := map[string]rule.Rule{}
+			for _, id := range []string{"1", "2"} {
+				r := &fakeRule{id: id}
+				r.run = func(context.Context) (rule.RuleResult, error) {
+					return rule.Result(r, rule.PassedCheckResult("foo", rule.NewTarget())), nil
+				}
+				rules[id] = r
+			}
+
+			var handled []ruleset.RulesetResult
+			hooks.Handler = func(result ruleset.RulesetResult) {
+				handled = append(handled, result)
+			}
+
+			_, err := sharedruleset.Run(ctx, rs, rules, hooks, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handled).To(HaveLen(2))
+			for _, result := range handled {
+				Expect(result.RulesetID).To(Equal("fake"))
+				Expect(result.RulesetVersion).To(Equal("v1"))
+				Expect(result.RuleResults).To(HaveLen(1))
+			}
+		})
+
+		It("should not run rules that have a resumed result and handle the resumed result", func() {
+			var ran []string
+			mu := sync.Mutex{}
+			rules := map[string]rule.Rule{}
+			for _, id := range []string{"1", "2", "3"} {
+				r := &fakeRule{id: id}
+				r.run = func(context.Context) (rule.RuleResult, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					ran = append(ran, r.ID())
+					return rule.Result(r, rule.PassedCheckResult("foo", rule.NewTarget())), nil
+				}
+				rules[id] = r
+			}
+
+			resumedResult := rule.RuleResult{
+				RuleID:       "2",
+				RuleName:     "Rule 2",
+				CheckResults: []rule.CheckResult{rule.FailedCheckResult("bar", rule.NewTarget())},
+			}
+			hooks.Resumed = func(rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, bool) {
+				if rulesetID == "fake" && rulesetVersion == "v1" && ruleID == "2" {
+					return resumedResult, true
+				}
+				return rule.RuleResult{}, false
+			}
+
+			var handled []ruleset.RulesetResult
+			hooks.Handler = func(result ruleset.RulesetResult) {
+				handled = append(handled, result)
+			}
+
+			result, err := sharedruleset.Run(ctx, rs, rules, hooks, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ran).To(ConsistOf("1", "3"))
+			Expect(handled).To(HaveLen(3))
+			Expect(handled).To(ContainElement(ruleset.RulesetResult{
+				RulesetID:      "fake",
+				RulesetName:    "Fake",
+				RulesetVersion: "v1",
+				RuleResults:    []rule.RuleResult{resumedResult},
+				ResumedRules:   []string{"2"},
+			}))
+			Expect(result.ResumedRules).To(Equal([]string{"2"}))
+			Expect(result.RuleResults).To(HaveLen(3))
+			Expect(result.RuleResults).To(ContainElement(resumedResult))
+		})
+
+		It("should apply the rule result modifier before handling the results", func() {
+			rules := map[string]rule.Rule{}
+			for _, id := range []string{"1", "2"} {
+				r := &fakeRule{id: id}
+				r.run = func(context.Context) (rule.RuleResult, error) {
+					return rule.Result(r, rule.FailedCheckResult("foo", rule.NewTarget())), nil
+				}
+				rules[id] = r
+			}
+
+			var handled []rule.RuleResult
+			hooks.Handler = func(result ruleset.RulesetResult) {
+				handled = append(handled, result.RuleResults...)
+			}
+			hooks.Modifier = func(rulesetID, rulesetVersion string, result rule.RuleResult) rule.RuleResult {
+				if rulesetID == "fake" && rulesetVersion == "v1" && result.RuleID == "2" {
+					result.CheckResults = []rule.CheckResult{rule.AcceptedCheckResult("known", rule.NewTarget())}
+				}
+				return result
+			}
+
+			result, err := sharedruleset.Run(ctx, rs, rules, hooks, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			accepted := rule.RuleResult{RuleID: "2", RuleName: "Fake rule 2", CheckResults: []rule.CheckResult{rule.AcceptedCheckResult("known", rule.NewTarget())}}
+			Expect(result.RuleResults).To(ContainElement(accepted))
+			Expect(handled).To(ContainElement(accepted))
+		})
+
+		It("should only run rules matched by the rule matcher", func() {
+			rules := map[string]rule.Rule{}
+			for _, id := range []string{"1", "2", "3"} {
+				r := &fakeRule{id: id}
+				r.run = func(context.Context) (rule.RuleResult, error) {
+					return rule.Result(r, rule.PassedCheckResult("foo", rule.NewTarget())), nil
+				}
+				rules[id] = r
+			}
+
+			hooks.Matcher = func(r rule.Rule) bool {
+				return r.ID() != "2"
+			}
+
+			result, err := sharedruleset.Run(ctx, rs, rules, hooks, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RuleResults).To(HaveLen(2))
+			Expect(result.RuleResults).NotTo(ContainElement(HaveField("RuleID", "2")))
+		})
+
+		It("should return an empty result when no rule is matched", func() {
+			hooks.Matcher = func(rule.Rule) bool { return false }
+
+			result, err := sharedruleset.Run(ctx, rs, map[string]rule.Rule{"1": &fakeRule{id: "1"}}, hooks, 2, logger)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RulesetID).To(Equal("fake"))
+			Expect(result.RuleResults).To(BeEmpty())
+		})
+	})
+
+	Describe("#SkipRulesExcept", func() {
+		It("should replace the rules that are not contained in the rule ids", func() {
+			rules := []rule.Rule{
+				&fakeRule{id: "1", severity: rule.SeverityHigh},
+				&fakeRule{id: "2", severity: rule.SeverityLow},
+				rule.NewSkipRule("3", "Skip rule", "Not applicable.", rule.Accepted),
+			}
+
+			result := sharedruleset.SkipRulesExcept(rules, []string{"1"}, "Rule needs a live cluster.")
+
+			Expect(result).To(HaveLen(3))
+			Expect(result[0]).To(BeIdenticalTo(rules[0]))
+			Expect(result[2]).To(BeIdenticalTo(rules[2]))
+
+			skipRule, ok := result[1].(*rule.SkipRule)
+			Expect(ok).To(BeTrue())
+			Expect(skipRule.ID()).To(Equal("2"))
+			Expect(skipRule.Name()).To(Equal("Fake rule 2"))
+			Expect(skipRule.Severity()).To(Equal(rule.SeverityLow))
+			Expect(skipRule.Status()).To(Equal(rule.Skipped))
+			Expect(skipRule.Justification()).To(Equal("Rule needs a live cluster."))
+		})
+	})
+})
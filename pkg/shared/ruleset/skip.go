@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ruleset
+
+import (
+	"slices"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// SkipRulesExcept replaces the rules whose ids are not contained in ruleIDs with
+// [rule.SkipRule]s that report a [rule.Skipped] check with the given justification.
+// The severity and labels of the replaced rules are kept. Rules that are already
+// skip rules are not replaced, so that they report their own status and justification.
+func SkipRulesExcept(rules []rule.Rule, ruleIDs []string, justification string) []rule.Rule {
+	result := make([]rule.Rule, 0, len(rules))
+	for _, r := range rules {
+		if _, ok := r.(*rule.SkipRule); ok || slices.Contains(ruleIDs, r.ID()) {
+			result = append(result, r)
+			continue
+		}
+
+		var options []rule.SkipRuleOption
+		if severityRule, ok := r.(rule.Severity); ok {
+			options = append(options, rule.SkipRuleWithSeverity(severityRule.Severity()))
+		}
+		if labelsRule, ok := r.(rule.Labels); ok {
+			options = append(options, rule.SkipRuleWithLabels(labelsRule.Labels()))
+		}
+		result = append(result, rule.NewSkipRule(r.ID(), r.Name(), justification, rule.Skipped, options...))
+	}
+	return result
+}